@@ -0,0 +1,134 @@
+package nownodes
+
+import "context"
+
+// AddressTxIterator walks all pages of an address history, returning one transaction at a time
+//
+// BlockBook returns the newest transactions first, so new transactions arriving mid-walk will
+// push already returned transactions onto later pages. Duplicates are skipped and if the
+// history shrinks (reorg or mempool eviction) the iterator steps back to avoid skipping entries.
+type AddressTxIterator struct {
+	address    string             // Address to walk
+	chain      Blockchain         // Blockchain of the address
+	err        error              // Last error (if any)
+	index      int                // Index on the current page
+	options    AddressOptions     // Options for each page request
+	page       uint64             // Current page (0 means no page has been fetched)
+	seen       map[string]bool    // Tx IDs already returned
+	service    AddressService     // Service used for fetching the pages
+	total      uint64             // Total transactions reported on the last page
+	totalPages uint64             // Total pages reported on the last page
+	tx         *TransactionInfo   // Current transaction (only in full transaction mode)
+	txID       string             // Current transaction ID
+	txIDs      []string           // Tx IDs on the current page
+	txs        []*TransactionInfo // Transactions on the current page (only in full transaction mode)
+}
+
+// NewAddressTxIterator will create a new iterator over all transactions of the given address
+//
+// This is a *Client method only (not part of the AddressService interface)
+// param: options is optional, use DetailsTxs or DetailsTxsLight to iterate full transactions
+// This method supports the following chains: BCH, BSV, BTC, BTCTestnet, BTG, DASH, DOGE, LTC
+func (c *Client) NewAddressTxIterator(chain Blockchain, address string,
+	options *AddressOptions) *AddressTxIterator {
+
+	it := &AddressTxIterator{
+		address: address,
+		chain:   chain,
+		seen:    make(map[string]bool),
+		service: c,
+	}
+	if options != nil {
+		it.options = *options
+	}
+
+	// Only tx ids or transactions can be iterated
+	if it.options.Details != DetailsTxs && it.options.Details != DetailsTxsLight {
+		it.options.Details = DetailsTxIDs
+	}
+	return it
+}
+
+// Next will advance to the next transaction, returns false when done or an error occurred
+func (it *AddressTxIterator) Next(ctx context.Context) bool {
+	for it.err == nil {
+
+		// Return the next unseen transaction on the current page
+		for it.index < len(it.txIDs) {
+			it.index++
+			txID := it.txIDs[it.index-1]
+			if it.seen[txID] {
+				continue
+			}
+			it.seen[txID] = true
+			it.txID = txID
+			it.tx = nil
+			if len(it.txs) >= it.index {
+				it.tx = it.txs[it.index-1]
+			}
+			return true
+		}
+
+		// No more pages
+		if it.page > 0 && it.page >= it.totalPages {
+			break
+		}
+		it.fetchPage(ctx)
+	}
+	it.tx = nil
+	it.txID = ""
+	return false
+}
+
+// Tx will return the current transaction (only when iterating with DetailsTxs or DetailsTxsLight)
+func (it *AddressTxIterator) Tx() *TransactionInfo {
+	return it.tx
+}
+
+// TxID will return the current transaction ID
+func (it *AddressTxIterator) TxID() string {
+	return it.txID
+}
+
+// Err will return the error (if any) that stopped the iteration
+func (it *AddressTxIterator) Err() error {
+	return it.err
+}
+
+// fetchPage will fetch the next page and detect if the history shifted since the last page
+func (it *AddressTxIterator) fetchPage(ctx context.Context) {
+	options := it.options
+	options.Page = it.page + 1
+
+	info, err := it.service.GetAddressWithOptions(ctx, it.chain, it.address, &options)
+	if err != nil {
+		it.err = err
+		return
+	}
+
+	// History shrank: entries moved to earlier pages, so step back and re-read them
+	if it.page > 0 && info.Txs < it.total && info.ItemsOnPage > 0 {
+		pagesBack := (it.total - info.Txs + info.ItemsOnPage - 1) / info.ItemsOnPage
+		if pagesBack > it.page {
+			pagesBack = it.page
+		}
+		it.page -= pagesBack
+		it.total = info.Txs
+		it.txIDs, it.txs, it.index = nil, nil, 0
+		return
+	}
+
+	// Set the page results
+	it.page = options.Page
+	it.total = info.Txs
+	it.totalPages = info.TotalPages
+	it.index = 0
+	it.txs = info.Transactions
+	it.txIDs = info.TxIDs
+	if len(it.txs) > 0 {
+		it.txIDs = make([]string, 0, len(it.txs))
+		for _, tx := range it.txs {
+			it.txIDs = append(it.txIDs, tx.TxID)
+		}
+	}
+}
@@ -0,0 +1,183 @@
+package nownodes
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// historyAddressResponse will return pages of an address history that can change between requests
+type historyAddressResponse struct {
+	sync.Mutex
+	afterFirstPage func(history []string) []string // Modify the history after the first page was served
+	fullTxs        bool                            // Return full transactions instead of tx ids
+	history        []string                        // Tx ids (newest first)
+	requests       int                             // Number of requests served
+}
+
+func (v *historyAddressResponse) Do(req *http.Request) (*http.Response, error) {
+	v.Lock()
+	defer v.Unlock()
+
+	resp := new(http.Response)
+	resp.StatusCode = http.StatusBadRequest
+
+	// No req found
+	if req == nil || !strings.Contains(req.URL.String(), routeGetAddress+testAddress(BSV)) {
+		resp.Body = io.NopCloser(bytes.NewBuffer([]byte(`{"error":"no-route-found"}`)))
+		return resp, errors.New("request not found")
+	}
+
+	// Find the requested page
+	page, _ := strconv.Atoi(req.URL.Query().Get("page"))
+	pageSize, _ := strconv.Atoi(req.URL.Query().Get("pageSize"))
+	start, end := (page-1)*pageSize, page*pageSize
+	if start > len(v.history) {
+		start = len(v.history)
+	}
+	if end > len(v.history) {
+		end = len(v.history)
+	}
+
+	info := &AddressInfo{
+		Address:     testAddress(BSV),
+		ItemsOnPage: uint64(pageSize),
+		Page:        uint64(page),
+		TotalPages:  uint64((len(v.history) + pageSize - 1) / pageSize),
+		Txs:         uint64(len(v.history)),
+	}
+	for _, txID := range v.history[start:end] {
+		if v.fullTxs {
+			info.Transactions = append(info.Transactions, &TransactionInfo{TxID: txID, Fees: "96"})
+		} else {
+			info.TxIDs = append(info.TxIDs, txID)
+		}
+	}
+
+	// Change the history after serving the first page
+	v.requests++
+	if v.requests == 1 && v.afterFirstPage != nil {
+		v.history = v.afterFirstPage(v.history)
+	}
+
+	body, _ := json.Marshal(info) //nolint:errchkjson // not going to produce an error
+	resp.StatusCode = http.StatusOK
+	resp.Body = io.NopCloser(bytes.NewBuffer(body))
+	return resp, nil
+}
+
+// testHistory will return a list of fake tx ids (newest first)
+func testHistory(count int) (history []string) {
+	for i := count; i > 0; i-- {
+		history = append(history, fmt.Sprintf("%064d", i))
+	}
+	return
+}
+
+// walkHistory will return all tx ids returned by the iterator
+func walkHistory(t *testing.T, it *AddressTxIterator) (txIDs []string) {
+	for it.Next(context.Background()) {
+		txIDs = append(txIDs, it.TxID())
+	}
+	require.NoError(t, it.Err())
+	return
+}
+
+func TestClient_NewAddressTxIterator(t *testing.T) {
+	t.Parallel()
+
+	t.Run("walk all pages", func(t *testing.T) {
+		c := NewClient(WithHTTPClient(&historyAddressResponse{history: testHistory(5)})).(*Client)
+		it := c.NewAddressTxIterator(BSV, testAddress(BSV), &AddressOptions{PageSize: 2})
+		txIDs := walkHistory(t, it)
+		assert.Equal(t, testHistory(5), txIDs)
+		assert.Nil(t, it.Tx())
+		assert.Empty(t, it.TxID())
+		assert.False(t, it.Next(context.Background()))
+	})
+
+	t.Run("empty history", func(t *testing.T) {
+		c := NewClient(WithHTTPClient(&historyAddressResponse{})).(*Client)
+		it := c.NewAddressTxIterator(BSV, testAddress(BSV), &AddressOptions{PageSize: 2})
+		assert.Empty(t, walkHistory(t, it))
+	})
+
+	t.Run("new transactions shift the pages", func(t *testing.T) {
+		c := NewClient(WithHTTPClient(&historyAddressResponse{
+			history: testHistory(5),
+			afterFirstPage: func(history []string) []string {
+				return append([]string{fmt.Sprintf("%064d", 7), fmt.Sprintf("%064d", 6)}, history...)
+			},
+		})).(*Client)
+		it := c.NewAddressTxIterator(BSV, testAddress(BSV), &AddressOptions{PageSize: 2})
+		assert.Equal(t, testHistory(5), walkHistory(t, it))
+	})
+
+	t.Run("removed transactions shift the pages", func(t *testing.T) {
+		c := NewClient(WithHTTPClient(&historyAddressResponse{
+			history: testHistory(7),
+			afterFirstPage: func(history []string) []string {
+				return history[2:]
+			},
+		})).(*Client)
+		it := c.NewAddressTxIterator(BSV, testAddress(BSV), &AddressOptions{PageSize: 2})
+		assert.Equal(t, testHistory(7), walkHistory(t, it))
+	})
+
+	t.Run("full transactions", func(t *testing.T) {
+		c := NewClient(WithHTTPClient(&historyAddressResponse{history: testHistory(3), fullTxs: true})).(*Client)
+		it := c.NewAddressTxIterator(BSV, testAddress(BSV), &AddressOptions{Details: DetailsTxs, PageSize: 2})
+		var count int
+		for it.Next(context.Background()) {
+			require.NotNil(t, it.Tx())
+			assert.Equal(t, it.TxID(), it.Tx().TxID)
+			assert.Equal(t, "96", it.Tx().Fees)
+			count++
+		}
+		require.NoError(t, it.Err())
+		assert.Equal(t, 3, count)
+	})
+
+	t.Run("basic details still iterate tx ids", func(t *testing.T) {
+		c := NewClient(WithHTTPClient(&validAddressTxsResponse{})).(*Client)
+		it := c.NewAddressTxIterator(BSV, testAddress(BSV), &AddressOptions{Details: DetailsBasic, PageSize: 1000})
+		require.True(t, it.Next(context.Background()))
+		assert.Equal(t, testBitcoinTxID, it.TxID())
+	})
+
+	t.Run("request error", func(t *testing.T) {
+		c := NewClient(WithHTTPClient(&errorDoReqErr{})).(*Client)
+		it := c.NewAddressTxIterator(BSV, testAddress(BSV), nil)
+		assert.False(t, it.Next(context.Background()))
+		require.Error(t, it.Err())
+	})
+
+	t.Run("invalid address", func(t *testing.T) {
+		c := NewClient(WithHTTPClient(&historyAddressResponse{history: testHistory(1)})).(*Client)
+		it := c.NewAddressTxIterator(BSV, "12345", nil)
+		assert.False(t, it.Next(context.Background()))
+		assert.ErrorIs(t, it.Err(), ErrInvalidAddress)
+	})
+}
+
+func ExampleClient_NewAddressTxIterator() {
+	c := NewClient(WithHTTPClient(&historyAddressResponse{history: testHistory(3)})).(*Client)
+	it := c.NewAddressTxIterator(BSV, testAddress(BSV), &AddressOptions{PageSize: 2})
+	var count int
+	for it.Next(context.Background()) {
+		count++
+	}
+	fmt.Printf("txs found: %d", count)
+	// Output:txs found: 3
+}
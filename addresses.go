@@ -2,39 +2,98 @@ package nownodes
 
 import (
 	"context"
+	"net/url"
+	"strconv"
 )
 
 // AddressInfo is the address information returned to the GetAddress request
 type AddressInfo struct {
-	Address            string   `json:"address"`
-	Balance            string   `json:"balance"`
-	ItemsOnPage        uint64   `json:"itemsOnPage"`
-	Page               uint64   `json:"page"`
-	TotalPages         uint64   `json:"totalPages"`
-	TotalReceived      string   `json:"totalReceived"`
-	TotalSent          string   `json:"totalSent"`
-	TxIDs              []string `json:"txids,omitempty"`
-	Txs                uint64   `json:"txs"`
-	UnconfirmedBalance string   `json:"unconfirmedBalance"`
-	UnconfirmedTxs     uint64   `json:"unconfirmedTxs"`
+	Address            string             `json:"address"`
+	Balance            string             `json:"balance"`
+	ItemsOnPage        uint64             `json:"itemsOnPage"`
+	Nonce              string             `json:"nonce,omitempty"`       // ETH
+	NonTokenTxs        uint64             `json:"nonTokenTxs,omitempty"` // ETH
+	Page               uint64             `json:"page"`
+	Tokens             []*Token           `json:"tokens,omitempty"` // Derived addresses (xpub) or ERC20 tokens (ETH)
+	TotalPages         uint64             `json:"totalPages"`
+	TotalReceived      string             `json:"totalReceived"`
+	TotalSent          string             `json:"totalSent"`
+	Transactions       []*TransactionInfo `json:"transactions,omitempty"` // Only with DetailsTxs or DetailsTxsLight
+	TxIDs              []string           `json:"txids,omitempty"`
+	Txs                uint64             `json:"txs"`
+	UnconfirmedBalance string             `json:"unconfirmedBalance"`
+	UnconfirmedTxs     uint64             `json:"unconfirmedTxs"`
+}
+
+// AddressOptions are the optional parameters for the GetAddressWithOptions request
+type AddressOptions struct {
+	Contract string      `json:"contract"`  // Filter the token transfers by contract address
+	Details  DetailLevel `json:"details"`   // Level of detail (default: txids)
+	From     uint64      `json:"from"`      // Starting block height (inclusive)
+	Page     uint64      `json:"page"`      // Page of transactions (default: 1)
+	PageSize uint64      `json:"page_size"` // Transactions per page (default: 1000)
+	To       uint64      `json:"to"`        // Ending block height (inclusive)
 }
 
 // GetAddress will get address information by a given address
 //
-// This method supports the following chains: BCH, BSV, BTC, BTCTestnet, BTG, DASH, DOGE, LTC
+// This method supports the following chains: BCH, BSV, BTC, BTCTestnet, BTG, DASH, DOGE, ETH, LTC
 func (c *Client) GetAddress(ctx context.Context, chain Blockchain, address string) (*AddressInfo, error) {
+	return c.GetAddressWithOptions(ctx, chain, address, nil)
+}
+
+// GetAddressWithOptions will get address information by a given address using the given options
+//
+// param: options is optional (nil will use the BlockBook defaults)
+// This method supports the following chains: BCH, BSV, BTC, BTCTestnet, BTG, DASH, DOGE, ETH, LTC
+func (c *Client) GetAddressWithOptions(ctx context.Context, chain Blockchain, address string,
+	options *AddressOptions) (*AddressInfo, error) {
 
 	// Validate the input
-	if !chain.ValidateAddress(address) {
+	if !isBlockchainSupported(getAddressBlockchains, chain) {
+		return nil, ErrUnsupportedBlockchain
+	} else if !chain.ValidateAddress(address) {
 		return nil, ErrInvalidAddress
 	}
 
+	// Use the WebSocket (if enabled)
+	if stream := c.streamTransport(chain); stream != nil {
+		return stream.GetAddress(ctx, address, options)
+	}
+
 	// Fire the HTTP request
 	info := new(AddressInfo)
 	if err := blockBookRequest(
-		ctx, c, getAddressBlockchains, chain, routeGetAddress+address, &info,
+		ctx, c, getAddressBlockchains, chain, routeGetAddress+address+options.queryString(), &info,
 	); err != nil {
 		return nil, err
 	}
 	return info, nil
 }
+
+// queryString will return the url query string for the options (if any)
+func (o *AddressOptions) queryString() string {
+	if o == nil {
+		return ""
+	}
+	values := url.Values{}
+	if len(o.Contract) > 0 {
+		values.Set("contract", o.Contract)
+	}
+	if len(o.Details) > 0 {
+		values.Set("details", string(o.Details))
+	}
+	if o.From > 0 {
+		values.Set("from", strconv.FormatUint(o.From, 10))
+	}
+	if o.Page > 0 {
+		values.Set("page", strconv.FormatUint(o.Page, 10))
+	}
+	if o.PageSize > 0 {
+		values.Set("pageSize", strconv.FormatUint(o.PageSize, 10))
+	}
+	if o.To > 0 {
+		values.Set("to", strconv.FormatUint(o.To, 10))
+	}
+	return encodeQuery(values)
+}
@@ -34,6 +34,7 @@ func (v *validAddressResponse) Do(req *http.Request) (*http.Response, error) {
 		BTG.String():  `{"page":1,"totalPages":13,"itemsOnPage":1000,"address":"ATTav2PtmotBZwxgWjrZCgaZpE89kcJ29B","balance":"121734541552","totalReceived":"14394650955688","totalSent":"14272916414136","unconfirmedBalance":"0","unconfirmedTxs":0,"txs":12287,"txids":["f52056973f853356d059e2cd05e214e8c405cab398240e811a54b622eff61c56","ca5a3bbd6245598ec1af177b2d56e370139d96217600dec1455216ae87211314","0ba336fe89ca26d04aaeabed8b2b252dba45004c389216af15290b6ff9179737"]}`,
 		DASH.String(): `{"page":1,"totalPages":1,"itemsOnPage":1000,"address":"Xe7tPVUvDpt52h2KykMpj4hmh8VsAaPCgt","balance":"0","totalReceived":"54100000","totalSent":"54100000","unconfirmedBalance":"0","unconfirmedTxs":0,"txs":2,"txids":["7c4738c76ba318e74af3e6f85f94ac15b44b4189bf76a9523aed71366a75445e","b9e4cebbf1cde3118ec62df72553a7d60fad78565028e8bf1c31edec23a1a393"]}`,
 		DOGE.String(): `{"page":1,"totalPages":18,"itemsOnPage":1000,"address":"ACSbgj91BsjdpuG6pBkG9LXtCTFaH4mn5a","balance":"16765841411433","totalReceived":"189519869773689377","totalSent":"189503103932277944","unconfirmedBalance":"0","unconfirmedTxs":0,"txs":17885,"txids":["9a3bf978b4e48251f7c5c640afc6cf5cf9f0888b7d9cfca3b4db65d14b99bccf","7b36a2713b5b3e12633a2a989dff177d31a0d49df50010e8822a5ce0ee70ab3d","c65acb715eec3eec0dd405ff0da566ee6b5a7af3c2cfc87a2c4d87a7e1c1f01e"]}`,
+		ETH.String():  `{"page":1,"totalPages":1,"itemsOnPage":1000,"address":"0x7dbF304559293bDccAc7cb18CB69375719b5E1CC","balance":"28731416563404906","unconfirmedBalance":"0","unconfirmedTxs":0,"txs":70,"nonTokenTxs":31,"txids":["0x193f9293a30bf668e3edd2290d49534770e6118faa201fe97da498cd8a995765"],"nonce":"70","tokens":[{"type":"ERC20","name":"Tether USD","contract":"0xA1C13E02a8b3F833D7B47Fa57BA6484f656Ee067","transfers":12,"symbol":"USDT","decimals":6,"balance":"1500"}]}`,
 		LTC.String():  `{"page":1,"totalPages":1,"itemsOnPage":1000,"address":"ltc1q684prk03qaz2uqenylktaq5z9qcg3dz7fgg0ps","balance":"4183327","totalReceived":"97526951","totalSent":"93343624","unconfirmedBalance":"0","unconfirmedTxs":0,"txs":32,"txids":["72c9a6bc19049c6f455477af8561a726352a61f55c5a16f8165f09503951d94d","1de19db94cab8f7bc4aaf15691c03732ed656ae8ce374e9b3d347520b563c49b","2381fc53f03717267895a885109075f8ba275401fbea13f9e1ab8994c3017889"]}`,
 	}
 
@@ -50,6 +51,37 @@ func (v *validAddressResponse) Do(req *http.Request) (*http.Response, error) {
 	return resp, errors.New("request not found")
 }
 
+// validAddressTxsResponse will return a valid address (with full transactions if requested)
+type validAddressTxsResponse struct{}
+
+func (v *validAddressTxsResponse) Do(req *http.Request) (*http.Response, error) {
+	resp := new(http.Response)
+	resp.StatusCode = http.StatusBadRequest
+
+	// No req found
+	if req == nil {
+		return resp, errors.New("missing request")
+	}
+
+	// Transactions or tx ids
+	txs := `"txids":["` + testBitcoinTxID + `"]`
+	if req.URL.Query().Get("details") == string(DetailsTxs) {
+		txs = `"transactions":[{"txid":"` + testBitcoinTxID + `","version":1,"vin":[{"txid":"cab4b07235120ed66aabcfc907b42be6c8782418461aebabd2ba58c08ca38ccc","vout":2,"sequence":4294967295,"n":0,"addresses":["1GenocdBC1NSHLMbk61fqJXqTdXjevCxCL"],"isAddress":true,"value":"546"}],"vout":[{"value":"450","n":1,"hex":"76a914f08d4568df6be038700227e70105b251455abaf188ac","addresses":["1NvvQjKN4GsyA9Y2kUT8PRvocAPJgCneFZ"],"isAddress":true}],"blockHash":"00000000000000000a032702d724591574cae47e729acdaf8b8a990adda3f72e","blockHeight":723772,"confirmations":622,"blockTime":1643111792,"value":"450","valueIn":"546","fees":"96"}]`
+	}
+
+	// Valid response
+	for _, chain := range getAddressBlockchains {
+		if strings.Contains(req.Host, chain.BlockBookURL()) && strings.Contains(req.URL.String(), routeGetAddress+testAddress(chain)) {
+			resp.StatusCode = http.StatusOK
+			resp.Body = io.NopCloser(bytes.NewBuffer([]byte(`{"page":` + req.URL.Query().Get("page") + `,"totalPages":174,"itemsOnPage":` + req.URL.Query().Get("pageSize") + `,"address":"` + testAddress(chain) + `","balance":"101556","totalReceived":"66351012","totalSent":"66249456","unconfirmedBalance":"0","unconfirmedTxs":0,"txs":173661,` + txs + `}`)))
+			return resp, nil
+		}
+	}
+
+	resp.Body = io.NopCloser(bytes.NewBuffer([]byte(`{"error":"no-route-found"}`)))
+	return resp, errors.New("request not found")
+}
+
 // errorInvalidAddress will return an error for the address response
 type errorInvalidAddress struct{}
 
@@ -282,12 +314,35 @@ func TestClient_GetAddress(t *testing.T) {
 	t.Run("unsupported chain", func(t *testing.T) {
 		c := NewClient(WithHTTPClient(&validAddressResponse{}))
 		ctx := context.Background()
-		info, err := c.GetAddress(ctx, ETH, testAddress(ETH))
+		info, err := c.GetAddress(ctx, Blockchain("unknown"), testAddress(BTC))
 		require.Error(t, err)
 		require.Nil(t, info)
 		assert.ErrorIs(t, err, ErrUnsupportedBlockchain)
 	})
 
+	t.Run("ethereum", func(t *testing.T) {
+		c := NewClient(WithHTTPClient(&validAddressResponse{}))
+		info, err := c.GetAddress(context.Background(), ETH, testAddress(ETH))
+		require.NoError(t, err)
+		require.NotNil(t, info)
+		assert.Equal(t, "28731416563404906", info.Balance)
+		assert.Equal(t, "70", info.Nonce)
+		assert.Equal(t, uint64(31), info.NonTokenTxs)
+		assert.Equal(t, []string{testETHTxID}, info.TxIDs)
+
+		// Tokens
+		require.Len(t, info.Tokens, 1)
+		assert.Equal(t, &Token{
+			Balance:   "1500",
+			Contract:  "0xA1C13E02a8b3F833D7B47Fa57BA6484f656Ee067",
+			Decimals:  6,
+			Name:      "Tether USD",
+			Symbol:    "USDT",
+			Transfers: 12,
+			Type:      "ERC20",
+		}, info.Tokens[0])
+	})
+
 	t.Run("error cases", func(t *testing.T) {
 
 		type testData struct {
@@ -352,6 +407,89 @@ func TestClient_GetAddress(t *testing.T) {
 	})
 }
 
+func TestClient_GetAddressWithOptions(t *testing.T) {
+	t.Parallel()
+
+	t.Run("paging with tx ids", func(t *testing.T) {
+		c := NewClient(WithAPIKey(testKey), WithHTTPClient(&validAddressTxsResponse{}))
+		info, err := c.GetAddressWithOptions(context.Background(), BSV, testAddress(BSV), &AddressOptions{
+			Details:  DetailsTxIDs,
+			From:     700000,
+			Page:     2,
+			PageSize: 10,
+			To:       723772,
+		})
+		require.NoError(t, err)
+		require.NotNil(t, info)
+		assert.Equal(t, uint64(2), info.Page)
+		assert.Equal(t, uint64(10), info.ItemsOnPage)
+		assert.Equal(t, []string{testBitcoinTxID}, info.TxIDs)
+		assert.Empty(t, info.Transactions)
+	})
+
+	t.Run("full transactions", func(t *testing.T) {
+		c := NewClient(WithAPIKey(testKey), WithHTTPClient(&validAddressTxsResponse{}))
+		info, err := c.GetAddressWithOptions(context.Background(), BSV, testAddress(BSV), &AddressOptions{
+			Details:  DetailsTxs,
+			Page:     1,
+			PageSize: 1,
+		})
+		require.NoError(t, err)
+		require.NotNil(t, info)
+		assert.Empty(t, info.TxIDs)
+		require.Len(t, info.Transactions, 1)
+		assert.Equal(t, testBitcoinTxID, info.Transactions[0].TxID)
+		assert.Equal(t, int64(723772), info.Transactions[0].BlockHeight)
+		require.Len(t, info.Transactions[0].Vin, 1)
+		require.Len(t, info.Transactions[0].VOut, 1)
+		assert.Equal(t, "450", info.Transactions[0].VOut[0].Value)
+	})
+
+	t.Run("invalid address", func(t *testing.T) {
+		c := NewClient(WithHTTPClient(&validAddressTxsResponse{}))
+		info, err := c.GetAddressWithOptions(context.Background(), BSV, "12345", &AddressOptions{Page: 1})
+		require.Error(t, err)
+		require.Nil(t, info)
+		assert.ErrorIs(t, err, ErrInvalidAddress)
+	})
+
+	t.Run("unsupported chain", func(t *testing.T) {
+		c := NewClient(WithHTTPClient(&validAddressTxsResponse{}))
+		info, err := c.GetAddressWithOptions(context.Background(), Blockchain("unknown"), testAddress(BTC), &AddressOptions{Page: 1})
+		require.Error(t, err)
+		require.Nil(t, info)
+		assert.ErrorIs(t, err, ErrUnsupportedBlockchain)
+	})
+
+	t.Run("ethereum", func(t *testing.T) {
+		c := NewClient(WithHTTPClient(&validAddressTxsResponse{}))
+		info, err := c.GetAddressWithOptions(context.Background(), ETH, testAddress(ETH), &AddressOptions{Page: 1, PageSize: 10})
+		require.NoError(t, err)
+		require.NotNil(t, info)
+		assert.Equal(t, testAddress(ETH), info.Address)
+		assert.Equal(t, uint64(10), info.ItemsOnPage)
+	})
+}
+
+func TestAddressOptions_queryString(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		options  *AddressOptions
+		expected string
+	}{
+		{nil, ""},
+		{&AddressOptions{}, ""},
+		{&AddressOptions{Details: DetailsTxs}, "?details=txs"},
+		{&AddressOptions{From: 1, To: 100}, "?from=1&to=100"},
+		{&AddressOptions{Page: 3, PageSize: 25}, "?page=3&pageSize=25"},
+		{&AddressOptions{Contract: "0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48"}, "?contract=0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48"},
+	}
+	for _, testCase := range tests {
+		assert.Equal(t, testCase.expected, testCase.options.queryString())
+	}
+}
+
 func ExampleClient_GetAddress() {
 	c := NewClient(WithHTTPClient(&validAddressResponse{}))
 	info, _ := c.GetAddress(context.Background(), BSV, testAddress(BSV))
@@ -0,0 +1,120 @@
+package nownodes
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"unicode/utf8"
+)
+
+// APIError is an error returned by the BlockBook API (or any non-successful HTTP response)
+//
+// Use errors.Is() with ErrNotFound, ErrUnauthorized or ErrRateLimited to match the error type,
+// a NodeAPI error (non-200) is available with errors.As() for the *RPCError
+type APIError struct {
+	Body       string `json:"body"`        // Body snippet of the response (truncated)
+	Message    string `json:"message"`     // Error message from the API (if any)
+	Method     string `json:"method"`      // HTTP method used
+	StatusCode int    `json:"status_code"` // HTTP status code
+	URL        string `json:"url"`         // Redacted URL used for the request
+	err        error  // Underlying error of the response (IE: the *RPCError of a NodeAPI request)
+}
+
+// newAPIError will create a new API error from the response
+func newAPIError(response *RequestResponse, message string) *APIError {
+	body := response.BodyContents
+	if len(body) > maxErrorBodyLength {
+		// Cut on a rune boundary so the snippet stays valid UTF-8
+		end := maxErrorBodyLength
+		for end > 0 && !utf8.RuneStart(body[end]) {
+			end--
+		}
+		body = body[:end]
+	}
+	return &APIError{
+		Body:       string(body),
+		Message:    message,
+		Method:     response.Method,
+		StatusCode: response.StatusCode,
+		URL:        redactURL(response.URL),
+	}
+}
+
+// Error will return the error message (implements the error interface)
+func (e *APIError) Error() string {
+	message := e.Message
+	if len(message) == 0 {
+		message = http.StatusText(e.StatusCode)
+	}
+	return fmt.Sprintf("status code [%d] error [%s]", e.StatusCode, message)
+}
+
+// Is will return true if the target is a sentinel matching the error (used by errors.Is)
+func (e *APIError) Is(target error) bool {
+	return errors.Is(errorSentinel(e.StatusCode, e.Message), target)
+}
+
+// Unwrap will return the underlying error (IE: the *RPCError of a NodeAPI request)
+func (e *APIError) Unwrap() error {
+	return e.err
+}
+
+// errorSentinel will return the sentinel (if any) for the HTTP status code or the known API error message
+//
+// Used by the HTTP and WebSocket (no status code) errors, messages of a server error (5xx) are not matched
+func errorSentinel(statusCode int, message string) error {
+	switch {
+	case statusCode == http.StatusNotFound:
+		return ErrNotFound
+	case statusCode == http.StatusTooManyRequests:
+		return ErrRateLimited
+	case statusCode == http.StatusUnauthorized || statusCode == http.StatusForbidden:
+		return ErrUnauthorized
+	case statusCode >= http.StatusInternalServerError:
+		return nil
+	}
+
+	// Known API error messages (NOWNodes returns a 200 for API key errors, BlockBook a 400 for a missing resource)
+	message = strings.ToLower(strings.TrimSpace(message))
+	switch {
+	case hasAnyPrefix(message, unauthorizedMessages):
+		return ErrUnauthorized
+	case hasAnyPrefix(message, rateLimitedMessages):
+		return ErrRateLimited
+	case strings.HasSuffix(message, notFoundMessageSuffix):
+		return ErrNotFound
+	default:
+		return nil
+	}
+}
+
+// hasAnyPrefix will return true if the value starts with any of the prefixes
+func hasAnyPrefix(value string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(value, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// redactURL will remove any credentials from the given url
+func redactURL(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return ""
+	}
+	if u.User != nil {
+		u.User = url.User(redactedValue)
+	}
+	query := u.Query()
+	for key := range query {
+		if strings.Contains(strings.ToLower(key), "key") {
+			query.Set(key, redactedValue)
+		}
+	}
+	u.RawQuery = query.Encode()
+	return u.String()
+}
@@ -0,0 +1,69 @@
+package nownodes
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+)
+
+// BalanceHistory is a single interval returned to the GetBalanceHistory request
+type BalanceHistory struct {
+	Rates      map[string]float64 `json:"rates,omitempty"` // Fiat rates at the interval (IE: usd: 7855.9)
+	Received   string             `json:"received"`
+	Sent       string             `json:"sent"`
+	SentToSelf string             `json:"sentToSelf"`
+	Time       int64              `json:"time"` // Start of the interval (unix timestamp)
+	Txs        uint64             `json:"txs"`
+}
+
+// BalanceHistoryOptions are the optional parameters for the GetBalanceHistory request
+type BalanceHistoryOptions struct {
+	FiatCurrency string `json:"fiat_currency"` // Only return the rate for this currency (IE: usd)
+	From         int64  `json:"from"`          // Starting time (unix timestamp)
+	GroupBy      uint64 `json:"group_by"`      // Interval length in seconds (default: 3600)
+	To           int64  `json:"to"`            // Ending time (unix timestamp)
+}
+
+// GetBalanceHistory will get the balance history for a given address or xpub
+//
+// param: options is optional (nil will use the BlockBook defaults)
+// This method supports the following chains: BCH, BSV, BTC, BTCTestnet, BTG, DASH, DOGE, LTC
+func (c *Client) GetBalanceHistory(ctx context.Context, chain Blockchain, addressOrXPub string,
+	options *BalanceHistoryOptions) ([]*BalanceHistory, error) {
+
+	// Validate the input (xpub or address)
+	if !chain.ValidateXPub(addressOrXPub) && !chain.ValidateAddress(addressOrXPub) {
+		return nil, ErrInvalidAddress
+	}
+
+	// Fire the HTTP request
+	var history []*BalanceHistory
+	if err := blockBookRequest(
+		ctx, c, getBalanceHistoryBlockchains, chain,
+		routeGetBalanceHistory+addressOrXPub+options.queryString(), &history,
+	); err != nil {
+		return nil, err
+	}
+	return history, nil
+}
+
+// queryString will return the url query string for the options (if any)
+func (o *BalanceHistoryOptions) queryString() string {
+	if o == nil {
+		return ""
+	}
+	values := url.Values{}
+	if len(o.FiatCurrency) > 0 {
+		values.Set("fiatcurrency", o.FiatCurrency)
+	}
+	if o.From > 0 {
+		values.Set("from", strconv.FormatInt(o.From, 10))
+	}
+	if o.GroupBy > 0 {
+		values.Set("groupBy", strconv.FormatUint(o.GroupBy, 10))
+	}
+	if o.To > 0 {
+		values.Set("to", strconv.FormatInt(o.To, 10))
+	}
+	return encodeQuery(values)
+}
@@ -0,0 +1,156 @@
+package nownodes
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// validBalanceHistoryResponse will return a valid balance history for all supported blockchains
+type validBalanceHistoryResponse struct{}
+
+func (v *validBalanceHistoryResponse) Do(req *http.Request) (*http.Response, error) {
+	resp := new(http.Response)
+	resp.StatusCode = http.StatusBadRequest
+
+	// No req found
+	if req == nil {
+		return resp, errors.New("missing request")
+	}
+
+	// Only return the requested currency (if given)
+	rates := `{"eur":38113.55,"usd":43000.32}`
+	if currency := req.URL.Query().Get("fiatcurrency"); len(currency) > 0 {
+		rates = `{"` + currency + `":43000.32}`
+	}
+
+	// Valid response (address or xpub)
+	for _, chain := range getBalanceHistoryBlockchains {
+		if strings.Contains(req.Host, chain.BlockBookURL()) &&
+			(strings.Contains(req.URL.String(), routeGetBalanceHistory+testAddress(chain)) || strings.Contains(req.URL.String(), routeGetBalanceHistory+testXPub)) {
+			resp.StatusCode = http.StatusOK
+			resp.Body = io.NopCloser(bytes.NewBuffer([]byte(`[{"time":1643068800,"txs":2,"received":"96496","sent":"0","sentToSelf":"0","rates":` + rates + `},{"time":1643155200,"txs":1,"received":"0","sent":"96760","sentToSelf":"96496","rates":` + rates + `}]`)))
+			return resp, nil
+		}
+	}
+
+	resp.Body = io.NopCloser(bytes.NewBuffer([]byte(`{"error":"no-route-found"}`)))
+	return resp, errors.New("request not found")
+}
+
+func TestClient_GetBalanceHistory(t *testing.T) {
+	t.Parallel()
+
+	t.Run("valid cases", func(t *testing.T) {
+		c := NewClient(WithAPIKey(testKey), WithHTTPClient(&validBalanceHistoryResponse{}))
+		ctx := context.Background()
+
+		for _, chain := range getBalanceHistoryBlockchains {
+			t.Run("chain "+chain.String()+": GetBalanceHistory("+testAddress(chain)+")", func(t *testing.T) {
+				history, err := c.GetBalanceHistory(ctx, chain, testAddress(chain), nil)
+				require.NoError(t, err)
+				require.Len(t, history, 2)
+
+				assert.Equal(t, int64(1643068800), history[0].Time)
+				assert.Equal(t, uint64(2), history[0].Txs)
+				assert.Equal(t, "96496", history[0].Received)
+				assert.Equal(t, "0", history[0].Sent)
+				assert.Equal(t, "96760", history[1].Sent)
+				assert.Equal(t, "96496", history[1].SentToSelf)
+				assert.Len(t, history[0].Rates, 2)
+				assert.InDelta(t, 43000.32, history[0].Rates["usd"], 0.001)
+			})
+		}
+	})
+
+	t.Run("xpub with options", func(t *testing.T) {
+		c := NewClient(WithHTTPClient(&validBalanceHistoryResponse{}))
+		history, err := c.GetBalanceHistory(context.Background(), BTC, testXPub, &BalanceHistoryOptions{
+			FiatCurrency: "usd",
+			From:         1643068800,
+			GroupBy:      86400,
+			To:           1643241600,
+		})
+		require.NoError(t, err)
+		require.Len(t, history, 2)
+		assert.Len(t, history[0].Rates, 1)
+		assert.InDelta(t, 43000.32, history[0].Rates["usd"], 0.001)
+	})
+
+	t.Run("missing or invalid address", func(t *testing.T) {
+		c := NewClient(WithHTTPClient(&validBalanceHistoryResponse{}))
+		for _, address := range []string{"", "12345", strings.Repeat("a", 111), testXPrv} {
+			history, err := c.GetBalanceHistory(context.Background(), BTC, address, nil)
+			require.Error(t, err)
+			require.Nil(t, history)
+			assert.ErrorIs(t, err, ErrInvalidAddress)
+		}
+	})
+
+	t.Run("unsupported chain", func(t *testing.T) {
+		c := NewClient(WithHTTPClient(&validBalanceHistoryResponse{}))
+		history, err := c.GetBalanceHistory(context.Background(), ETH, testAddress(ETH), nil)
+		require.Error(t, err)
+		require.Nil(t, history)
+		assert.ErrorIs(t, err, ErrUnsupportedBlockchain)
+	})
+
+	t.Run("error cases", func(t *testing.T) {
+		for _, chain := range getBalanceHistoryBlockchains {
+			t.Run("chain "+chain.String()+": http req error", func(t *testing.T) {
+				c := NewClient(WithHTTPClient(&errorDoReqErr{}))
+				history, err := c.GetBalanceHistory(context.Background(), chain, testAddress(chain), nil)
+				require.Error(t, err)
+				require.Nil(t, history)
+			})
+
+			t.Run("chain "+chain.String()+": invalid json response", func(t *testing.T) {
+				c := NewClient(WithHTTPClient(&errorBadJSONResponse{}))
+				history, err := c.GetBalanceHistory(context.Background(), chain, testAddress(chain), nil)
+				require.Error(t, err)
+				require.Nil(t, history)
+			})
+		}
+	})
+}
+
+func TestBalanceHistoryOptions_queryString(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		options  *BalanceHistoryOptions
+		expected string
+	}{
+		{nil, ""},
+		{&BalanceHistoryOptions{}, ""},
+		{&BalanceHistoryOptions{FiatCurrency: "usd"}, "?fiatcurrency=usd"},
+		{&BalanceHistoryOptions{From: 1, To: 2, GroupBy: 86400}, "?from=1&groupBy=86400&to=2"},
+	}
+	for _, testCase := range tests {
+		assert.Equal(t, testCase.expected, testCase.options.queryString())
+	}
+}
+
+func ExampleClient_GetBalanceHistory() {
+	c := NewClient(WithHTTPClient(&validBalanceHistoryResponse{}))
+	history, _ := c.GetBalanceHistory(context.Background(), BSV, testAddress(BSV), &BalanceHistoryOptions{GroupBy: 86400})
+	fmt.Printf("intervals found: %d", len(history))
+	// Output:intervals found: 2
+}
+
+func BenchmarkClient_GetBalanceHistory(b *testing.B) {
+	c := NewClient(WithHTTPClient(&validBalanceHistoryResponse{}))
+	ctx := context.Background()
+	address := testAddress(BSV)
+	for i := 0; i < b.N; i++ {
+		_, _ = c.GetBalanceHistory(ctx, BSV, address, nil)
+	}
+}
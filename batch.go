@@ -0,0 +1,122 @@
+package nownodes
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+)
+
+// Batch is a set of NodeAPI (JSON-RPC) calls sent in a single HTTP request
+type Batch struct {
+	calls  []*BatchCall // Calls in the order they were added
+	chain  Blockchain   // Blockchain for all calls
+	client *Client      // Client used for the request
+}
+
+// BatchCall is a single call in a batch, the error (if any) is set after the batch is sent
+type BatchCall struct {
+	Error  error         `json:"error"`  // Error for this call (node error, decode error or missing response)
+	ID     string        `json:"id"`     // Unique ID in the batch
+	Method string        `json:"method"` // NodeAPI method
+	Params []interface{} `json:"params"` // NodeAPI params
+	Result interface{}   `json:"result"` // Model for the result (optional)
+}
+
+// NewBatch will create a new (empty) batch of NodeAPI calls for the given blockchain
+//
+// This method supports the following chains: BCH, BSV, BTC, BTCTestnet, BTG, DASH, DOGE, LTC
+func (c *Client) NewBatch(chain Blockchain) *Batch {
+	return &Batch{
+		chain:  chain,
+		client: c,
+	}
+}
+
+// Add will add a call to the batch and imbue the result into the given model once sent
+//
+// param: result is optional (nil will discard the result)
+func (b *Batch) Add(method string, params []interface{}, result interface{}) *BatchCall {
+	if params == nil {
+		params = []interface{}{}
+	}
+	call := &BatchCall{
+		ID:     strconv.Itoa(len(b.calls)),
+		Method: method,
+		Params: params,
+		Result: result,
+	}
+	b.calls = append(b.calls, call)
+	return call
+}
+
+// Calls will return all calls in the batch
+func (b *Batch) Calls() []*BatchCall {
+	return b.calls
+}
+
+// Len will return the number of calls in the batch
+func (b *Batch) Len() int {
+	return len(b.calls)
+}
+
+// Send will send all calls in a single request and set the result or error on each call
+//
+// The returned error is only for the request itself, errors for each call are set on the call
+func (b *Batch) Send(ctx context.Context) error {
+
+	// Create the payloads (skip invalid calls, clear errors from a previous send)
+	payloads := make([]nodePayload, 0, len(b.calls))
+	for _, call := range b.calls {
+		call.Error = nil
+		if len(call.Method) == 0 {
+			call.Error = ErrInvalidMethod
+			continue
+		}
+		payloads = append(payloads, nodePayload{
+			APIKey:  b.client.options.apiKey,
+			ID:      call.ID,
+			JSONRPC: "2.0",
+			Method:  call.Method,
+			Params:  call.Params,
+		})
+	}
+
+	// Nothing to send
+	if len(payloads) == 0 {
+		return nil
+	}
+
+	// Fire the HTTP request
+	payload, err := json.Marshal(payloads)
+	if err != nil {
+		return err
+	}
+	var responses []*rpcResponse
+	if err = nodeRequest(
+		ctx, b.client, batchBlockchains, b.chain, payload, &responses,
+	); err != nil {
+		return err
+	}
+
+	// Match the responses to the calls by id
+	responseByID := make(map[string]*rpcResponse, len(responses))
+	for _, resp := range responses {
+		if resp != nil {
+			responseByID[resp.ID] = resp
+		}
+	}
+	for _, call := range b.calls {
+		if call.Error != nil {
+			continue
+		}
+		resp, ok := responseByID[call.ID]
+		if !ok {
+			call.Error = ErrMissingBatchResponse
+		} else if resp.Error != nil {
+			call.Error = resp.Error
+		} else if call.Result != nil && len(resp.Result) > 0 {
+			call.Error = json.Unmarshal(resp.Result, call.Result)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,195 @@
+package nownodes
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// validBatchResponse will return the batch responses (in reverse order) for all supported blockchains
+type validBatchResponse struct{}
+
+func (v *validBatchResponse) Do(req *http.Request) (*http.Response, error) {
+	resp := new(http.Response)
+	resp.StatusCode = http.StatusBadRequest
+
+	// No req found
+	if req == nil {
+		return resp, errors.New("missing request")
+	}
+
+	var data []nodePayload
+	if err := json.NewDecoder(req.Body).Decode(&data); err != nil {
+		return resp, err
+	}
+
+	// Valid response
+	for _, chain := range batchBlockchains {
+		if strings.Contains(req.Host, chain.NodeAPIURL()) {
+			var responses []string
+			for i := len(data) - 1; i >= 0; i-- {
+				switch {
+				case data[i].Method == "dropped":
+					continue
+				case data[i].Method == nodeMethodGetMempoolEntry && data[i].Params[0] == testTxID(chain):
+					responses = append(responses, `{"result":{"size":381,"fee":9.6e-7,"modifiedfee":9.6e-7,"time":1643661192,"height":724704,"depends":[]},"error":null,"id":"`+data[i].ID+`"}`)
+				case data[i].Method == nodeMethodGetMempoolEntry:
+					responses = append(responses, `{"result":null,"error":{"code":-5,"message":"Transaction not in mempool"},"id":"`+data[i].ID+`"}`)
+				default:
+					responses = append(responses, `{"result":723772,"error":null,"id":"`+data[i].ID+`"}`)
+				}
+			}
+			resp.StatusCode = http.StatusOK
+			resp.Body = io.NopCloser(bytes.NewBuffer([]byte(`[` + strings.Join(responses, ",") + `]`)))
+			return resp, nil
+		}
+	}
+
+	resp.Body = io.NopCloser(bytes.NewBuffer([]byte(`{"error":"no-route-found"}`)))
+	return resp, errors.New("request not found")
+}
+
+func TestClient_NewBatch(t *testing.T) {
+	t.Parallel()
+
+	t.Run("valid cases", func(t *testing.T) {
+		c := NewClient(WithAPIKey(testKey), WithHTTPClient(&validBatchResponse{}))
+		ctx := context.Background()
+
+		for _, chain := range batchBlockchains {
+			t.Run("chain "+chain.String()+": Send()", func(t *testing.T) {
+				batch := c.NewBatch(chain)
+				entry := new(MempoolEntry)
+				var height uint64
+				found := batch.Add(nodeMethodGetMempoolEntry, []interface{}{testTxID(chain)}, entry)
+				missing := batch.Add(nodeMethodGetMempoolEntry, []interface{}{testBitcoinTxHexID}, new(MempoolEntry))
+				count := batch.Add("getblockcount", nil, &height)
+				require.Equal(t, 3, batch.Len())
+				require.Len(t, batch.Calls(), 3)
+
+				err := batch.Send(ctx)
+				require.NoError(t, err)
+
+				require.NoError(t, found.Error)
+				assert.Equal(t, int64(1643661192), entry.Time)
+				assert.Equal(t, uint64(724704), entry.Height)
+
+				require.Error(t, missing.Error)
+				var nodeErr *RPCError
+				require.ErrorAs(t, missing.Error, &nodeErr)
+				assert.Equal(t, int64(-5), nodeErr.Code)
+
+				require.NoError(t, count.Error)
+				assert.Equal(t, uint64(723772), height)
+			})
+		}
+	})
+
+	t.Run("missing response", func(t *testing.T) {
+		c := NewClient(WithHTTPClient(&validBatchResponse{}))
+		batch := c.NewBatch(BSV)
+		dropped := batch.Add("dropped", nil, nil)
+		kept := batch.Add("getblockcount", nil, nil)
+		require.NoError(t, batch.Send(context.Background()))
+		assert.ErrorIs(t, dropped.Error, ErrMissingBatchResponse)
+		assert.NoError(t, kept.Error)
+	})
+
+	t.Run("invalid result model", func(t *testing.T) {
+		c := NewClient(WithHTTPClient(&validBatchResponse{}))
+		batch := c.NewBatch(BSV)
+		var invalid string
+		call := batch.Add("getblockcount", nil, &invalid)
+		require.NoError(t, batch.Send(context.Background()))
+		assert.Error(t, call.Error)
+	})
+
+	t.Run("retry clears previous errors", func(t *testing.T) {
+		c := NewClient(WithHTTPClient(&validBatchResponse{}))
+		batch := c.NewBatch(BSV)
+		var invalid string
+		call := batch.Add("getblockcount", nil, &invalid)
+		require.NoError(t, batch.Send(context.Background()))
+		require.Error(t, call.Error)
+
+		var count int64
+		call.Result = &count
+		require.NoError(t, batch.Send(context.Background()))
+		assert.NoError(t, call.Error)
+		assert.Equal(t, int64(723772), count)
+	})
+
+	t.Run("missing method", func(t *testing.T) {
+		c := NewClient(WithHTTPClient(&validBatchResponse{}))
+		batch := c.NewBatch(BSV)
+		invalid := batch.Add("", nil, nil)
+		valid := batch.Add("getblockcount", nil, nil)
+		require.NoError(t, batch.Send(context.Background()))
+		assert.ErrorIs(t, invalid.Error, ErrInvalidMethod)
+		assert.NoError(t, valid.Error)
+	})
+
+	t.Run("empty batch", func(t *testing.T) {
+		c := NewClient(WithHTTPClient(&errorDoReqErr{}))
+		batch := c.NewBatch(BSV)
+		require.NoError(t, batch.Send(context.Background()))
+	})
+
+	t.Run("unsupported chain", func(t *testing.T) {
+		c := NewClient(WithHTTPClient(&validBatchResponse{}))
+		batch := c.NewBatch(ETH)
+		batch.Add("getblockcount", nil, nil)
+		err := batch.Send(context.Background())
+		require.Error(t, err)
+		assert.ErrorIs(t, err, ErrUnsupportedBlockchain)
+	})
+
+	t.Run("error cases", func(t *testing.T) {
+		for _, chain := range batchBlockchains {
+			t.Run("chain "+chain.String()+": http req error", func(t *testing.T) {
+				c := NewClient(WithHTTPClient(&errorDoReqErr{}))
+				batch := c.NewBatch(chain)
+				batch.Add("getblockcount", nil, nil)
+				require.Error(t, batch.Send(context.Background()))
+			})
+
+			t.Run("chain "+chain.String()+": invalid json response", func(t *testing.T) {
+				c := NewClient(WithHTTPClient(&errorBadJSONResponse{}))
+				batch := c.NewBatch(chain)
+				batch.Add("getblockcount", nil, nil)
+				require.Error(t, batch.Send(context.Background()))
+			})
+		}
+	})
+}
+
+func ExampleClient_NewBatch() {
+	c := NewClient(WithHTTPClient(&validBatchResponse{}))
+	batch := c.NewBatch(BSV)
+	entry := new(MempoolEntry)
+	call := batch.Add("getmempoolentry", []interface{}{testTxID(BSV)}, entry)
+	_ = batch.Send(context.Background())
+	fmt.Printf("tx in mempool time: %d (error: %v)", entry.Time, call.Error)
+	// Output:tx in mempool time: 1643661192 (error: <nil>)
+}
+
+func BenchmarkBatch_Send(b *testing.B) {
+	c := NewClient(WithHTTPClient(&validBatchResponse{}))
+	ctx := context.Background()
+	for i := 0; i < b.N; i++ {
+		batch := c.NewBatch(BSV)
+		for j := 0; j < 100; j++ {
+			batch.Add(nodeMethodGetMempoolEntry, []interface{}{testTxID(BSV)}, new(MempoolEntry))
+		}
+		_ = batch.Send(ctx)
+	}
+}
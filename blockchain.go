@@ -1,7 +1,10 @@
 package nownodes
 
 import (
+	"bytes"
+	"crypto/sha256"
 	"encoding/hex"
+	"math/big"
 	"strings"
 )
 
@@ -115,6 +118,61 @@ func (n Blockchain) ValidateAddress(address string) bool {
 	}
 }
 
+// ValidateXPub will validate the extended public key (xpub, ypub, zpub, etc.)
+//
+// The key must be base58 encoded with a valid checksum and hold a public key (never an xprv)
+func (n Blockchain) ValidateXPub(xPub string) bool {
+	switch n {
+	case BCH, BSV, BTC, BTCTestnet, BTG, DASH, DOGE, LTC:
+		return isExtendedPublicKey(xPub)
+	default:
+		return false
+	}
+}
+
+// isExtendedPublicKey will return true if the string is a serialized extended public key (any version prefix)
+func isExtendedPublicKey(xPub string) bool {
+	data, ok := decodeBase58(xPub)
+	if !ok || len(data) != extendedKeyLength+extendedKeyChecksumSize {
+		return false
+	}
+
+	// Double SHA256 checksum
+	payload := data[:extendedKeyLength]
+	first := sha256.Sum256(payload)
+	second := sha256.Sum256(first[:])
+	if !bytes.Equal(second[:extendedKeyChecksumSize], data[extendedKeyLength:]) {
+		return false
+	}
+
+	// Compressed public key (a private key starts with 0x00)
+	return payload[extendedKeyDataOffset] == 0x02 || payload[extendedKeyDataOffset] == 0x03
+}
+
+// decodeBase58 will decode the base58 string (false if empty or an invalid character is found)
+func decodeBase58(value string) ([]byte, bool) {
+	if len(value) == 0 {
+		return nil, false
+	}
+	number := new(big.Int)
+	radix := big.NewInt(int64(len(base58Alphabet)))
+	for _, r := range value {
+		index := strings.IndexRune(base58Alphabet, r)
+		if index < 0 {
+			return nil, false
+		}
+		number.Mul(number, radix)
+		number.Add(number, big.NewInt(int64(index)))
+	}
+
+	// Leading ones are leading zero bytes
+	var zeros int
+	for zeros < len(value) && value[zeros] == base58Alphabet[0] {
+		zeros++
+	}
+	return append(make([]byte, zeros), number.Bytes()...), true
+}
+
 // isBlockchainSupported will return true if the blockchain was found in the list
 func isBlockchainSupported(list []Blockchain, blockchain Blockchain) bool {
 	for _, chain := range list {
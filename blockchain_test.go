@@ -1,6 +1,7 @@
 package nownodes
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -166,6 +167,39 @@ func TestBlockchain_ValidateTxHex(t *testing.T) {
 	})
 }
 
+func TestBlockchain_ValidateXPub(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		chain    Blockchain
+		xPub     string
+		expected bool
+	}{
+		{BTC, testXPub, true},
+		{BTC, "xpub661MyMwAqRbcFtXgS5sYJABqqG9YLmC4Q1Rdap9gSE8NqtwybGhePY2gZ29ESFjqJoCu1Rupje8YtGqsefD265TMg7usUDFdp6W1EGMcet8", true},
+		{LTC, testXPub, true},
+		{BTC, "", false},
+		{BTC, "12345", false},
+		{BTC, strings.Repeat("a", 111), false},
+		{BTC, testXPub[:110] + "a", false},
+		{BTC, testXPub[:50] + "0" + testXPub[51:], false},
+		{BTC, testXPrv, false},
+		{BTC, testAddress(BTC), false},
+		{ETH, testXPub, false},
+	}
+
+	for _, testCase := range tests {
+		t.Run("chain "+testCase.chain.String()+": ValidateXPub()", func(t *testing.T) {
+			assert.Equal(t, testCase.expected, testCase.chain.ValidateXPub(testCase.xPub), testCase.xPub)
+		})
+	}
+
+	t.Run("unknown blockchain", func(t *testing.T) {
+		u := Blockchain("unknown")
+		assert.Equal(t, false, u.ValidateXPub(testXPub))
+	})
+}
+
 func TestBlockchain_ValidateAddress(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,103 @@
+package nownodes
+
+import (
+	"context"
+	"encoding/hex"
+	"net/url"
+	"strconv"
+)
+
+// BlockInfo is the block information returned to the GetBlock request
+type BlockInfo struct {
+	Bits              string             `json:"bits"`
+	BlockHash         string             `json:"hash"`
+	Confirmations     int64              `json:"confirmations"`
+	Difficulty        string             `json:"difficulty"`
+	Height            uint64             `json:"height"`
+	ItemsOnPage       uint64             `json:"itemsOnPage"`
+	MerkleRoot        string             `json:"merkleRoot"`
+	NextBlockHash     string             `json:"nextBlockHash,omitempty"`
+	Nonce             string             `json:"nonce"`
+	Page              uint64             `json:"page"`
+	PreviousBlockHash string             `json:"previousBlockHash"`
+	Size              uint64             `json:"size"`
+	Time              int64              `json:"time"`
+	TotalPages        uint64             `json:"totalPages"`
+	TxCount           uint64             `json:"txCount"`
+	TxIDs             []string           `json:"txids,omitempty"`
+	Txs               []*TransactionInfo `json:"txs,omitempty"`
+	Version           int64              `json:"version"`
+}
+
+// BlockHashInfo is the block hash returned to the GetBlockHash request
+type BlockHashInfo struct {
+	BlockHash string `json:"blockHash"`
+}
+
+// GetBlock will get block information by a given block hash or height
+//
+// param: hashOrHeight is a block hash (64 hex characters) or a block height
+// param: page is the page of transactions to return (0 will use the default page)
+// This method supports the following chains: BCH, BSV, BTC, BTCTestnet, BTG, DASH, DOGE, LTC
+func (c *Client) GetBlock(ctx context.Context, chain Blockchain, hashOrHeight string,
+	page uint64) (*BlockInfo, error) {
+
+	// Validate the input
+	if !isBlockHashOrHeight(hashOrHeight) {
+		return nil, ErrInvalidBlock
+	}
+
+	// Add the page if requested
+	endpoint := routeGetBlock + url.PathEscape(hashOrHeight)
+	if page > 0 {
+		endpoint += "?page=" + strconv.FormatUint(page, 10)
+	}
+
+	// Fire the HTTP request
+	info := new(BlockInfo)
+	if err := blockBookRequest(
+		ctx, c, getBlockBlockchains, chain, endpoint, &info,
+	); err != nil {
+		return nil, err
+	}
+	return info, nil
+}
+
+// GetBlockHash will get the block hash for a given block height
+//
+// This method supports the following chains: BCH, BSV, BTC, BTCTestnet, BTG, DASH, DOGE, LTC
+func (c *Client) GetBlockHash(ctx context.Context, chain Blockchain, height uint64) (*BlockHashInfo, error) {
+
+	// Validate the input
+	if height > bitcoinMaxBlockHeight {
+		return nil, ErrInvalidBlock
+	}
+
+	// Fire the HTTP request
+	info := new(BlockHashInfo)
+	if err := blockBookRequest(
+		ctx, c, getBlockHashBlockchains, chain,
+		routeGetBlockHash+strconv.FormatUint(height, 10), &info,
+	); err != nil {
+		return nil, err
+	}
+	return info, nil
+}
+
+// isBlockHashOrHeight will return true if the value is a block hash (64 hex characters) or a block height
+func isBlockHashOrHeight(value string) bool {
+	if len(value) == bitcoinBlockHashLength {
+		return isBlockHash(value)
+	}
+	height, err := strconv.ParseUint(value, 10, 64)
+	return err == nil && height <= bitcoinMaxBlockHeight
+}
+
+// isBlockHash will return true if the value is a block hash (64 hex characters)
+func isBlockHash(value string) bool {
+	if len(value) != bitcoinBlockHashLength {
+		return false
+	}
+	_, err := hex.DecodeString(value)
+	return err == nil
+}
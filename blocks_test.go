@@ -0,0 +1,252 @@
+package nownodes
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testBlockHash   = "00000000000000000a032702d724591574cae47e729acdaf8b8a990adda3f72e"
+	testBlockHeight = 723772
+)
+
+// validBlockResponse will return a valid block for all supported blockchains
+type validBlockResponse struct{}
+
+func (v *validBlockResponse) Do(req *http.Request) (*http.Response, error) {
+	resp := new(http.Response)
+	resp.StatusCode = http.StatusBadRequest
+
+	// No req found
+	if req == nil {
+		return resp, errors.New("missing request")
+	}
+
+	// Requested page (if any)
+	page := req.URL.Query().Get("page")
+	if len(page) == 0 {
+		page = "1"
+	}
+
+	// Valid response (get block)
+	for _, chain := range getBlockBlockchains {
+		if strings.Contains(req.Host, chain.BlockBookURL()) && strings.Contains(req.URL.String(), routeGetBlock) {
+			resp.StatusCode = http.StatusOK
+			resp.Body = io.NopCloser(bytes.NewBuffer([]byte(`{"page":` + page + `,"totalPages":2,"itemsOnPage":1000,"hash":"` + testBlockHash + `","previousBlockHash":"000000000000000003fd96ab6aefaf2c0ab3b1dbab1a4ca7b3d2ac6ba1dc0e1d","nextBlockHash":"0000000000000000043a1d7cb1d1f57aee4aa7c95e3e4a6fdd8e8d0e7e0b3dbe","height":723772,"confirmations":622,"size":23853,"time":1643111792,"version":536870912,"merkleRoot":"e1c9d5a9e8c8ac4bb1e3dd8d7b8a4cb7c9b7e8ef7c0f1e0c7ebc7d5b4b1f3a2e","nonce":"2911231425","bits":"180a7f3c","difficulty":"106878089091.1158","txCount":2,"txs":[{"txid":"` + testBitcoinTxID + `","vin":[],"vout":[],"blockHash":"` + testBlockHash + `","blockHeight":723772,"confirmations":622,"blockTime":1643111792,"value":"450","valueIn":"546","fees":"96"}]}`)))
+			return resp, nil
+		}
+	}
+
+	// Valid response (get block hash)
+	for _, chain := range getBlockHashBlockchains {
+		if strings.Contains(req.Host, chain.BlockBookURL()) && strings.Contains(req.URL.String(), routeGetBlockHash) {
+			resp.StatusCode = http.StatusOK
+			resp.Body = io.NopCloser(bytes.NewBuffer([]byte(`{"blockHash":"` + testBlockHash + `"}`)))
+			return resp, nil
+		}
+	}
+
+	resp.Body = io.NopCloser(bytes.NewBuffer([]byte(`{"error":"no-route-found"}`)))
+	return resp, errors.New("request not found")
+}
+
+// errorBlockNotFoundResponse will return an error for the block response
+type errorBlockNotFoundResponse struct{}
+
+func (v *errorBlockNotFoundResponse) Do(req *http.Request) (*http.Response, error) {
+	resp := new(http.Response)
+	resp.StatusCode = http.StatusBadRequest
+
+	// No req found
+	if req == nil {
+		return resp, errors.New("missing request")
+	}
+
+	resp.Body = io.NopCloser(bytes.NewBuffer([]byte(`{"error": "Block not found"}`)))
+	return resp, nil
+}
+
+func TestClient_GetBlock(t *testing.T) {
+	t.Parallel()
+
+	t.Run("valid cases", func(t *testing.T) {
+		c := NewClient(WithAPIKey(testKey), WithHTTPClient(&validBlockResponse{}))
+		ctx := context.Background()
+
+		for _, chain := range getBlockBlockchains {
+			t.Run("chain "+chain.String()+": GetBlock("+testBlockHash+")", func(t *testing.T) {
+				info, err := c.GetBlock(ctx, chain, testBlockHash, 0)
+				require.NoError(t, err)
+				require.NotNil(t, info)
+
+				assert.Equal(t, testBlockHash, info.BlockHash)
+				assert.Equal(t, uint64(testBlockHeight), info.Height)
+				assert.Equal(t, uint64(1), info.Page)
+				assert.Equal(t, uint64(2), info.TotalPages)
+				assert.Equal(t, uint64(2), info.TxCount)
+				assert.Equal(t, "180a7f3c", info.Bits)
+				assert.Equal(t, int64(1643111792), info.Time)
+				require.Len(t, info.Txs, 1)
+				assert.Equal(t, testBitcoinTxID, info.Txs[0].TxID)
+			})
+		}
+	})
+
+	t.Run("valid case with page", func(t *testing.T) {
+		c := NewClient(WithHTTPClient(&validBlockResponse{}))
+		info, err := c.GetBlock(context.Background(), BSV, "723772", 2)
+		require.NoError(t, err)
+		require.NotNil(t, info)
+		assert.Equal(t, uint64(2), info.Page)
+	})
+
+	t.Run("missing or invalid block", func(t *testing.T) {
+		c := NewClient(WithHTTPClient(&validBlockResponse{}))
+		for _, hashOrHeight := range []string{
+			"", "-1", "12345abc", "../status", "723772?page=1", "2147483648", testBlockHash[:63], testBlockHash[:63] + "z",
+		} {
+			info, err := c.GetBlock(context.Background(), BSV, hashOrHeight, 0)
+			require.Error(t, err)
+			require.Nil(t, info)
+			assert.ErrorIs(t, err, ErrInvalidBlock)
+		}
+	})
+
+	t.Run("unsupported chain", func(t *testing.T) {
+		c := NewClient(WithHTTPClient(&validBlockResponse{}))
+		info, err := c.GetBlock(context.Background(), ETH, testBlockHash, 0)
+		require.Error(t, err)
+		require.Nil(t, info)
+		assert.ErrorIs(t, err, ErrUnsupportedBlockchain)
+	})
+
+	t.Run("error cases", func(t *testing.T) {
+		for _, chain := range getBlockBlockchains {
+			t.Run("chain "+chain.String()+": block not found", func(t *testing.T) {
+				c := NewClient(WithHTTPClient(&errorBlockNotFoundResponse{}))
+				info, err := c.GetBlock(context.Background(), chain, testBlockHash, 0)
+				require.Error(t, err)
+				require.Nil(t, info)
+			})
+
+			t.Run("chain "+chain.String()+": http req error", func(t *testing.T) {
+				c := NewClient(WithHTTPClient(&errorDoReqErr{}))
+				info, err := c.GetBlock(context.Background(), chain, testBlockHash, 0)
+				require.Error(t, err)
+				require.Nil(t, info)
+			})
+
+			t.Run("chain "+chain.String()+": invalid json response", func(t *testing.T) {
+				c := NewClient(WithHTTPClient(&errorBadJSONResponse{}))
+				info, err := c.GetBlock(context.Background(), chain, testBlockHash, 0)
+				require.Error(t, err)
+				require.Nil(t, info)
+			})
+
+			t.Run("chain "+chain.String()+": missing api key", func(t *testing.T) {
+				c := NewClient(WithHTTPClient(&errorMissingAPIKey{}))
+				info, err := c.GetBlock(context.Background(), chain, testBlockHash, 0)
+				require.Error(t, err)
+				require.Nil(t, info)
+			})
+		}
+	})
+}
+
+func ExampleClient_GetBlock() {
+	c := NewClient(WithHTTPClient(&validBlockResponse{}))
+	info, _ := c.GetBlock(context.Background(), BSV, testBlockHash, 0)
+	fmt.Printf("block found: %d", info.Height)
+	// Output:block found: 723772
+}
+
+func BenchmarkClient_GetBlock(b *testing.B) {
+	c := NewClient(WithHTTPClient(&validBlockResponse{}))
+	ctx := context.Background()
+	for i := 0; i < b.N; i++ {
+		_, _ = c.GetBlock(ctx, BSV, testBlockHash, 0)
+	}
+}
+
+func TestClient_GetBlockHash(t *testing.T) {
+	t.Parallel()
+
+	t.Run("valid cases", func(t *testing.T) {
+		c := NewClient(WithAPIKey(testKey), WithHTTPClient(&validBlockResponse{}))
+		ctx := context.Background()
+
+		for _, chain := range getBlockHashBlockchains {
+			t.Run("chain "+chain.String()+": GetBlockHash()", func(t *testing.T) {
+				info, err := c.GetBlockHash(ctx, chain, testBlockHeight)
+				require.NoError(t, err)
+				require.NotNil(t, info)
+				assert.Equal(t, testBlockHash, info.BlockHash)
+			})
+		}
+	})
+
+	t.Run("invalid height", func(t *testing.T) {
+		c := NewClient(WithHTTPClient(&validBlockResponse{}))
+		info, err := c.GetBlockHash(context.Background(), BSV, bitcoinMaxBlockHeight+1)
+		require.Error(t, err)
+		require.Nil(t, info)
+		assert.ErrorIs(t, err, ErrInvalidBlock)
+	})
+
+	t.Run("unsupported chain", func(t *testing.T) {
+		c := NewClient(WithHTTPClient(&validBlockResponse{}))
+		info, err := c.GetBlockHash(context.Background(), ETH, testBlockHeight)
+		require.Error(t, err)
+		require.Nil(t, info)
+		assert.ErrorIs(t, err, ErrUnsupportedBlockchain)
+	})
+
+	t.Run("error cases", func(t *testing.T) {
+		for _, chain := range getBlockHashBlockchains {
+			t.Run("chain "+chain.String()+": block not found", func(t *testing.T) {
+				c := NewClient(WithHTTPClient(&errorBlockNotFoundResponse{}))
+				info, err := c.GetBlockHash(context.Background(), chain, testBlockHeight)
+				require.Error(t, err)
+				require.Nil(t, info)
+			})
+
+			t.Run("chain "+chain.String()+": http req error", func(t *testing.T) {
+				c := NewClient(WithHTTPClient(&errorDoReqErr{}))
+				info, err := c.GetBlockHash(context.Background(), chain, testBlockHeight)
+				require.Error(t, err)
+				require.Nil(t, info)
+			})
+
+			t.Run("chain "+chain.String()+": invalid json response", func(t *testing.T) {
+				c := NewClient(WithHTTPClient(&errorBadJSONResponse{}))
+				info, err := c.GetBlockHash(context.Background(), chain, testBlockHeight)
+				require.Error(t, err)
+				require.Nil(t, info)
+			})
+		}
+	})
+}
+
+func ExampleClient_GetBlockHash() {
+	c := NewClient(WithHTTPClient(&validBlockResponse{}))
+	info, _ := c.GetBlockHash(context.Background(), BSV, testBlockHeight)
+	fmt.Println("block hash found: " + info.BlockHash)
+	// Output:block hash found: 00000000000000000a032702d724591574cae47e729acdaf8b8a990adda3f72e
+}
+
+func BenchmarkClient_GetBlockHash(b *testing.B) {
+	c := NewClient(WithHTTPClient(&validBlockResponse{}))
+	ctx := context.Background()
+	for i := 0; i < b.N; i++ {
+		_, _ = c.GetBlockHash(ctx, BSV, testBlockHeight)
+	}
+}
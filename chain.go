@@ -0,0 +1,156 @@
+package nownodes
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+)
+
+// BlockchainInfo is the chain state returned to the GetBlockchainInfo request
+type BlockchainInfo struct {
+	AutomaticPruning     bool          `json:"automatic_pruning,omitempty"` // BTC
+	BestBlockHash        string        `json:"bestblockhash"`
+	Blocks               uint64        `json:"blocks"`
+	Chain                string        `json:"chain"` // IE: main, test
+	ChainWork            string        `json:"chainwork"`
+	Difficulty           float64       `json:"difficulty"`
+	Headers              uint64        `json:"headers"`
+	InitialBlockDownload bool          `json:"initialblockdownload"`
+	MedianTime           int64         `json:"mediantime"`
+	PruneHeight          uint64        `json:"pruneheight,omitempty"`
+	Pruned               bool          `json:"pruned"`
+	SizeOnDisk           uint64        `json:"size_on_disk,omitempty"` // BTC, BCH, LTC, DOGE
+	SoftForks            NodeSoftForks `json:"softforks,omitempty"`    // Format differs by chain and node version
+	Time                 int64         `json:"time,omitempty"`         // BTC (v23+)
+	VerificationProgress float64       `json:"verificationprogress"`
+	Warnings             NodeWarnings  `json:"warnings"`
+}
+
+// NodeWarnings are the node warnings (a string on older nodes, a list on Bitcoin Core v28+)
+type NodeWarnings []string
+
+// NodeSoftForks are the soft fork details by name (IE: taproot, bip34)
+//
+// Bitcoin Core v19+ returns an object (by name), older nodes (BSV, DOGE, BTG, DASH) return a list (by id)
+type NodeSoftForks map[string]json.RawMessage
+
+// ChainTip is a known tip returned to the GetChainTips request
+type ChainTip struct {
+	BranchLen uint64 `json:"branchlen"` // Zero for the main chain
+	Hash      string `json:"hash"`
+	Height    uint64 `json:"height"`
+	Status    string `json:"status"` // IE: active, valid-fork, valid-headers, headers-only, invalid
+}
+
+// UnmarshalJSON will decode the warnings from a string (empty is no warnings) or a list of strings
+func (w *NodeWarnings) UnmarshalJSON(data []byte) error {
+	var warning string
+	if err := json.Unmarshal(data, &warning); err == nil {
+		*w = nil
+		if len(warning) > 0 {
+			*w = NodeWarnings{warning}
+		}
+		return nil
+	}
+	var warnings []string
+	if err := json.Unmarshal(data, &warnings); err != nil {
+		return err
+	}
+	*w = warnings
+	return nil
+}
+
+// UnmarshalJSON will decode the soft forks from an object (by name) or a list of objects (by id)
+func (f *NodeSoftForks) UnmarshalJSON(data []byte) error {
+	var forks map[string]json.RawMessage
+	if err := json.Unmarshal(data, &forks); err == nil {
+		*f = forks
+		return nil
+	}
+	var list []json.RawMessage
+	if err := json.Unmarshal(data, &list); err != nil {
+		return err
+	}
+	forks = make(map[string]json.RawMessage, len(list))
+	for _, fork := range list {
+		var softFork struct {
+			ID string `json:"id"`
+		}
+		if err := json.Unmarshal(fork, &softFork); err != nil {
+			return err
+		}
+		forks[softFork.ID] = fork
+	}
+	*f = forks
+	return nil
+}
+
+// String will return all warnings as a single string (the format of older nodes)
+func (w NodeWarnings) String() string {
+	return strings.Join(w, " ")
+}
+
+// GetBlockchainInfo will get the current chain state of the node
+//
+// This method supports the following chains: BCH, BSV, BTC, BTCTestnet, BTG, DASH, DOGE, LTC
+func (c *Client) GetBlockchainInfo(ctx context.Context, chain Blockchain) (*BlockchainInfo, error) {
+	info := new(BlockchainInfo)
+	if err := c.call(
+		ctx, getBlockchainInfoBlockchains, chain, nodeMethodGetBlockchainInfo, nil, info,
+	); err != nil {
+		return nil, err
+	}
+	return info, nil
+}
+
+// GetBlockCount will get the height of the most-work fully-validated chain
+//
+// This method supports the following chains: BCH, BSV, BTC, BTCTestnet, BTG, DASH, DOGE, LTC
+func (c *Client) GetBlockCount(ctx context.Context, chain Blockchain) (uint64, error) {
+	var count uint64
+	if err := c.call(
+		ctx, getBlockCountBlockchains, chain, nodeMethodGetBlockCount, nil, &count,
+	); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// GetBestBlockHash will get the hash of the best (tip) block in the most-work fully-validated chain
+//
+// This method supports the following chains: BCH, BSV, BTC, BTCTestnet, BTG, DASH, DOGE, LTC
+func (c *Client) GetBestBlockHash(ctx context.Context, chain Blockchain) (string, error) {
+	var hash string
+	if err := c.call(
+		ctx, getBestBlockHashBlockchains, chain, nodeMethodGetBestBlockHash, nil, &hash,
+	); err != nil {
+		return "", err
+	}
+	return hash, nil
+}
+
+// GetChainTips will get all known tips in the block tree (main chain and orphaned branches)
+//
+// This method supports the following chains: BCH, BSV, BTC, BTCTestnet, BTG, DASH, DOGE, LTC
+func (c *Client) GetChainTips(ctx context.Context, chain Blockchain) ([]*ChainTip, error) {
+	var tips []*ChainTip
+	if err := c.call(
+		ctx, getChainTipsBlockchains, chain, nodeMethodGetChainTips, nil, &tips,
+	); err != nil {
+		return nil, err
+	}
+	return tips, nil
+}
+
+// GetDifficulty will get the proof-of-work difficulty as a multiple of the minimum difficulty
+//
+// This method supports the following chains: BCH, BSV, BTC, BTCTestnet, BTG, DASH, DOGE, LTC
+func (c *Client) GetDifficulty(ctx context.Context, chain Blockchain) (float64, error) {
+	var difficulty float64
+	if err := c.call(
+		ctx, getDifficultyBlockchains, chain, nodeMethodGetDifficulty, nil, &difficulty,
+	); err != nil {
+		return 0, err
+	}
+	return difficulty, nil
+}
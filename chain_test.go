@@ -0,0 +1,296 @@
+package nownodes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// chainResults are the NodeAPI results for the chain state methods
+var chainResults = map[string]string{
+	nodeMethodGetBestBlockHash:  `"` + testBlockHash + `"`,
+	nodeMethodGetBlockchainInfo: `{"chain":"main","blocks":723772,"headers":723772,"bestblockhash":"` + testBlockHash + `","difficulty":26690525287405.5,"mediantime":1643109263,"verificationprogress":0.9999992854845712,"initialblockdownload":false,"chainwork":"00000000000000000000000000000000000000002a4b2e1c1ce4b43a6b4c4e1e","size_on_disk":438560474528,"pruned":false,"softforks":{"taproot":{"type":"bip9","active":true,"height":709632}},"warnings":""}`,
+	nodeMethodGetBlockCount:     `723772`,
+	nodeMethodGetChainTips:      `[{"height":723772,"hash":"` + testBlockHash + `","branchlen":0,"status":"active"},{"height":723519,"hash":"00000000000000000004e6d4b9a3e5e4fdbd2b6c0b4f4e3a1c2b3d4e5f6a7b8c","branchlen":1,"status":"valid-fork"}]`,
+	nodeMethodGetDifficulty:     `26690525287405.5`,
+}
+
+func TestClient_GetBlockchainInfo(t *testing.T) {
+	t.Parallel()
+
+	t.Run("valid cases", func(t *testing.T) {
+		c := NewClient(WithAPIKey(testKey), WithHTTPClient(&nodeMethodResponse{results: chainResults}))
+		for _, chain := range getBlockchainInfoBlockchains {
+			t.Run("chain "+chain.String()+": GetBlockchainInfo()", func(t *testing.T) {
+				info, err := c.GetBlockchainInfo(context.Background(), chain)
+				require.NoError(t, err)
+				require.NotNil(t, info)
+				assert.Equal(t, "main", info.Chain)
+				assert.Equal(t, uint64(723772), info.Blocks)
+				assert.Equal(t, uint64(723772), info.Headers)
+				assert.Equal(t, testBlockHash, info.BestBlockHash)
+				assert.InDelta(t, 26690525287405.5, info.Difficulty, 0.1)
+				assert.Equal(t, int64(1643109263), info.MedianTime)
+				assert.False(t, info.InitialBlockDownload)
+				assert.Equal(t, uint64(438560474528), info.SizeOnDisk)
+				assert.Contains(t, info.SoftForks, "taproot")
+				assert.Empty(t, info.Warnings)
+			})
+		}
+	})
+
+	t.Run("warnings list (v28+)", func(t *testing.T) {
+		c := NewClient(WithHTTPClient(&nodeMethodResponse{results: map[string]string{
+			nodeMethodGetBlockchainInfo: `{"chain":"main","blocks":723772,"warnings":["This is a pre-release test build","Unknown new rules activated"]}`,
+		}}))
+		info, err := c.GetBlockchainInfo(context.Background(), BTC)
+		require.NoError(t, err)
+		require.NotNil(t, info)
+		assert.Equal(t, NodeWarnings{"This is a pre-release test build", "Unknown new rules activated"}, info.Warnings)
+	})
+
+	t.Run("soft forks list (older nodes)", func(t *testing.T) {
+		c := NewClient(WithHTTPClient(&nodeMethodResponse{results: map[string]string{
+			nodeMethodGetBlockchainInfo: `{"chain":"main","blocks":723772,"softforks":[{"id":"bip34","version":2,"reject":{"status":true}},{"id":"bip66","version":3,"reject":{"status":true}}],"warnings":""}`,
+		}}))
+		for _, chain := range []Blockchain{BSV, BTG, DASH, DOGE} {
+			t.Run("chain "+chain.String(), func(t *testing.T) {
+				info, err := c.GetBlockchainInfo(context.Background(), chain)
+				require.NoError(t, err)
+				require.NotNil(t, info)
+				require.Len(t, info.SoftForks, 2)
+				assert.JSONEq(t, `{"id":"bip34","version":2,"reject":{"status":true}}`, string(info.SoftForks["bip34"]))
+				assert.Contains(t, info.SoftForks, "bip66")
+			})
+		}
+	})
+
+	t.Run("unsupported chain", func(t *testing.T) {
+		c := NewClient(WithHTTPClient(&nodeMethodResponse{results: chainResults}))
+		info, err := c.GetBlockchainInfo(context.Background(), ETH)
+		require.Error(t, err)
+		require.Nil(t, info)
+		assert.ErrorIs(t, err, ErrUnsupportedBlockchain)
+	})
+
+	t.Run("error cases", func(t *testing.T) {
+		for _, chain := range getBlockchainInfoBlockchains {
+			t.Run("chain "+chain.String()+": method not found", func(t *testing.T) {
+				c := NewClient(WithHTTPClient(&nodeMethodResponse{}))
+				info, err := c.GetBlockchainInfo(context.Background(), chain)
+				require.Error(t, err)
+				require.Nil(t, info)
+			})
+
+			t.Run("chain "+chain.String()+": http req error", func(t *testing.T) {
+				c := NewClient(WithHTTPClient(&errorDoReqErr{}))
+				info, err := c.GetBlockchainInfo(context.Background(), chain)
+				require.Error(t, err)
+				require.Nil(t, info)
+			})
+
+			t.Run("chain "+chain.String()+": invalid json response", func(t *testing.T) {
+				c := NewClient(WithHTTPClient(&errorBadJSONResponse{}))
+				info, err := c.GetBlockchainInfo(context.Background(), chain)
+				require.Error(t, err)
+				require.Nil(t, info)
+			})
+		}
+	})
+}
+
+func TestNodeWarnings_UnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		input    string
+		expected NodeWarnings
+		err      bool
+	}{
+		{`""`, nil, false},
+		{`null`, nil, false},
+		{`"Warning: unknown new rules activated"`, NodeWarnings{"Warning: unknown new rules activated"}, false},
+		{`[]`, NodeWarnings{}, false},
+		{`["first","second"]`, NodeWarnings{"first", "second"}, false},
+		{`123`, nil, true},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.input, func(t *testing.T) {
+			var warnings NodeWarnings
+			err := json.Unmarshal([]byte(testCase.input), &warnings)
+			if testCase.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expected, warnings)
+		})
+	}
+}
+
+func TestNodeSoftForks_UnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		input    string
+		expected []string
+		err      bool
+	}{
+		{`null`, nil, false},
+		{`{}`, nil, false},
+		{`{"taproot":{"type":"bip9","active":true}}`, []string{"taproot"}, false},
+		{`[]`, nil, false},
+		{`[{"id":"bip34","version":2},{"id":"csv","version":4}]`, []string{"bip34", "csv"}, false},
+		{`"invalid"`, nil, true},
+		{`[1]`, nil, true},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.input, func(t *testing.T) {
+			var forks NodeSoftForks
+			err := json.Unmarshal([]byte(testCase.input), &forks)
+			if testCase.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, forks, len(testCase.expected))
+			for _, name := range testCase.expected {
+				assert.Contains(t, forks, name)
+			}
+		})
+	}
+}
+
+func TestNodeWarnings_String(t *testing.T) {
+	assert.Equal(t, "", NodeWarnings(nil).String())
+	assert.Equal(t, "first second", NodeWarnings{"first", "second"}.String())
+}
+
+func TestClient_GetBlockCount(t *testing.T) {
+	t.Parallel()
+
+	t.Run("valid cases", func(t *testing.T) {
+		c := NewClient(WithAPIKey(testKey), WithHTTPClient(&nodeMethodResponse{results: chainResults}))
+		for _, chain := range getBlockCountBlockchains {
+			count, err := c.GetBlockCount(context.Background(), chain)
+			require.NoError(t, err)
+			assert.Equal(t, uint64(723772), count)
+		}
+	})
+
+	t.Run("error cases", func(t *testing.T) {
+		c := NewClient(WithHTTPClient(&nodeMethodResponse{}))
+		count, err := c.GetBlockCount(context.Background(), BSV)
+		require.Error(t, err)
+		assert.Equal(t, uint64(0), count)
+
+		c = NewClient(WithHTTPClient(&nodeMethodResponse{results: chainResults}))
+		_, err = c.GetBlockCount(context.Background(), ETH)
+		assert.ErrorIs(t, err, ErrUnsupportedBlockchain)
+	})
+}
+
+func TestClient_GetBestBlockHash(t *testing.T) {
+	t.Parallel()
+
+	t.Run("valid cases", func(t *testing.T) {
+		c := NewClient(WithAPIKey(testKey), WithHTTPClient(&nodeMethodResponse{results: chainResults}))
+		for _, chain := range getBestBlockHashBlockchains {
+			hash, err := c.GetBestBlockHash(context.Background(), chain)
+			require.NoError(t, err)
+			assert.Equal(t, testBlockHash, hash)
+		}
+	})
+
+	t.Run("error cases", func(t *testing.T) {
+		c := NewClient(WithHTTPClient(&nodeMethodResponse{}))
+		hash, err := c.GetBestBlockHash(context.Background(), BSV)
+		require.Error(t, err)
+		assert.Empty(t, hash)
+
+		c = NewClient(WithHTTPClient(&nodeMethodResponse{results: chainResults}))
+		_, err = c.GetBestBlockHash(context.Background(), ETH)
+		assert.ErrorIs(t, err, ErrUnsupportedBlockchain)
+	})
+}
+
+func TestClient_GetChainTips(t *testing.T) {
+	t.Parallel()
+
+	t.Run("valid cases", func(t *testing.T) {
+		c := NewClient(WithAPIKey(testKey), WithHTTPClient(&nodeMethodResponse{results: chainResults}))
+		for _, chain := range getChainTipsBlockchains {
+			tips, err := c.GetChainTips(context.Background(), chain)
+			require.NoError(t, err)
+			require.Len(t, tips, 2)
+			assert.Equal(t, uint64(723772), tips[0].Height)
+			assert.Equal(t, "active", tips[0].Status)
+			assert.Equal(t, uint64(0), tips[0].BranchLen)
+			assert.Equal(t, "valid-fork", tips[1].Status)
+			assert.Equal(t, uint64(1), tips[1].BranchLen)
+		}
+	})
+
+	t.Run("error cases", func(t *testing.T) {
+		c := NewClient(WithHTTPClient(&nodeMethodResponse{}))
+		tips, err := c.GetChainTips(context.Background(), BSV)
+		require.Error(t, err)
+		assert.Nil(t, tips)
+
+		c = NewClient(WithHTTPClient(&nodeMethodResponse{results: chainResults}))
+		_, err = c.GetChainTips(context.Background(), ETH)
+		assert.ErrorIs(t, err, ErrUnsupportedBlockchain)
+	})
+}
+
+func TestClient_GetDifficulty(t *testing.T) {
+	t.Parallel()
+
+	t.Run("valid cases", func(t *testing.T) {
+		c := NewClient(WithAPIKey(testKey), WithHTTPClient(&nodeMethodResponse{results: chainResults}))
+		for _, chain := range getDifficultyBlockchains {
+			difficulty, err := c.GetDifficulty(context.Background(), chain)
+			require.NoError(t, err)
+			assert.InDelta(t, 26690525287405.5, difficulty, 0.1)
+		}
+	})
+
+	t.Run("error cases", func(t *testing.T) {
+		c := NewClient(WithHTTPClient(&nodeMethodResponse{}))
+		difficulty, err := c.GetDifficulty(context.Background(), BSV)
+		require.Error(t, err)
+		assert.Zero(t, difficulty)
+
+		c = NewClient(WithHTTPClient(&nodeMethodResponse{results: chainResults}))
+		_, err = c.GetDifficulty(context.Background(), ETH)
+		assert.ErrorIs(t, err, ErrUnsupportedBlockchain)
+	})
+}
+
+func ExampleClient_GetBlockchainInfo() {
+	c := NewClient(WithHTTPClient(&nodeMethodResponse{results: chainResults}))
+	info, _ := c.GetBlockchainInfo(context.Background(), BTC)
+	fmt.Printf("blocks: %d", info.Blocks)
+	// Output:blocks: 723772
+}
+
+func ExampleClient_GetBlockCount() {
+	c := NewClient(WithHTTPClient(&nodeMethodResponse{results: chainResults}))
+	count, _ := c.GetBlockCount(context.Background(), BSV)
+	fmt.Printf("block count: %d", count)
+	// Output:block count: 723772
+}
+
+func BenchmarkClient_GetBlockchainInfo(b *testing.B) {
+	c := NewClient(WithHTTPClient(&nodeMethodResponse{results: chainResults}))
+	ctx := context.Background()
+	for i := 0; i < b.N; i++ {
+		_, _ = c.GetBlockchainInfo(ctx, BTC)
+	}
+}
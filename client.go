@@ -3,6 +3,9 @@ package nownodes
 import (
 	"net"
 	"net/http"
+	"strconv"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/gojektech/heimdall/v6"
@@ -12,15 +15,20 @@ import (
 type (
 	// Client is the client configuration and options
 	Client struct {
-		options *ClientOptions // Options are all the default settings / configuration
+		lastID    uint64                       // Last NodeAPI request ID (atomic)
+		options   *ClientOptions               // Options are all the default settings / configuration
+		streams   map[Blockchain]*StreamClient // WebSocket transports (one per chain, if enabled)
+		streamsMu sync.Mutex
 	}
 
 	// ClientOptions holds all the configuration for client requests and default resources
 	ClientOptions struct {
-		apiKey      string        // The user's API key for NOWNode API
-		httpClient  HTTPInterface // HTTP client interface
-		httpOptions *HTTPOptions  // Options for the HTTP client
-		userAgent   string        // User agent for all outgoing requests
+		apiKey          string         // The user's API key for NOWNode API
+		httpClient      HTTPInterface  // HTTP client interface
+		httpOptions     *HTTPOptions   // Options for the HTTP client
+		streamOptions   *StreamOptions // Options for the WebSocket transport
+		streamTransport bool           // Use the WebSocket for the BlockBook requests (if supported)
+		userAgent       string         // User agent for all outgoing requests
 	}
 
 	// HTTPOptions holds all the configuration for the HTTP client
@@ -103,6 +111,7 @@ func NewClient(opts ...ClientOps) ClientInterface {
 			httpOptions: DefaultHTTPOptions(),
 			userAgent:   defaultUserAgent,
 		},
+		streams: make(map[Blockchain]*StreamClient),
 	}
 
 	// Overwrite defaults with any set by user
@@ -127,3 +136,40 @@ func (c *Client) HTTPClient() HTTPInterface {
 func (c *Client) UserAgent() string {
 	return c.options.userAgent
 }
+
+// Close will close the WebSocket transports (if any), the client can still be used (they will reconnect)
+func (c *Client) Close() error {
+	c.streamsMu.Lock()
+	streams := c.streams
+	c.streams = make(map[Blockchain]*StreamClient)
+	c.streamsMu.Unlock()
+
+	var err error
+	for _, stream := range streams {
+		if closeErr := stream.Close(); closeErr != nil && err == nil {
+			err = closeErr
+		}
+	}
+	return err
+}
+
+// streamTransport will return the WebSocket transport for the chain (nil if not enabled or not supported)
+func (c *Client) streamTransport(chain Blockchain) *StreamClient {
+	if !c.options.streamTransport || !isBlockchainSupported(streamBlockchains, chain) {
+		return nil
+	}
+
+	c.streamsMu.Lock()
+	defer c.streamsMu.Unlock()
+	stream, ok := c.streams[chain]
+	if !ok {
+		stream = c.NewStreamClient(chain, c.options.streamOptions)
+		c.streams[chain] = stream
+	}
+	return stream
+}
+
+// nextRequestID will return the next (unique) NodeAPI request ID
+func (c *Client) nextRequestID() string {
+	return strconv.FormatUint(atomic.AddUint64(&c.lastID, 1), 10)
+}
@@ -23,6 +23,19 @@ func DefaultHTTPOptions() (clientOptions *HTTPOptions) {
 	}
 }
 
+// DefaultStreamOptions will return the default WebSocket stream option values
+func DefaultStreamOptions() (streamOptions *StreamOptions) {
+	return &StreamOptions{
+		BackOffExponentFactor: 2.0,
+		BackOffInitialTimeout: 500 * time.Millisecond,
+		BackOffMaxTimeout:     30 * time.Second,
+		BufferSize:            16,
+		DialTimeout:           10 * time.Second,
+		MaxAddresses:          defaultMaxStreamAddresses,
+		PingInterval:          30 * time.Second,
+	}
+}
+
 // WithAPIKey will store the API key on the client for all future requests
 func WithAPIKey(apiKey string) ClientOps {
 	return func(c *ClientOptions) {
@@ -50,6 +63,19 @@ func WithHTTPOptions(opts *HTTPOptions) ClientOps {
 	}
 }
 
+// WithStreamTransport will send the BlockBook requests over a single (per chain) WebSocket connection
+//
+// Used by: GetAddress, GetAddressWithOptions, GetTransaction, SendTransaction & EstimateFee
+// The connection is not shared with any NewStreamClient() subscriptions, and the errors match
+// the same sentinels as the HTTP requests (ErrNotFound, ErrRateLimited & ErrUnauthorized)
+// param: options is optional (nil will use DefaultStreamOptions())
+func WithStreamTransport(options *StreamOptions) ClientOps {
+	return func(c *ClientOptions) {
+		c.streamOptions = options
+		c.streamTransport = true
+	}
+}
+
 // WithUserAgent will overwrite the default useragent
 func WithUserAgent(userAgent string) ClientOps {
 	return func(c *ClientOptions) {
@@ -120,3 +120,29 @@ func TestWithUserAgent(t *testing.T) {
 		assert.Equal(t, testUserAgent, options.userAgent)
 	})
 }
+
+func TestWithStreamTransport(t *testing.T) {
+	t.Parallel()
+
+	t.Run("check type", func(t *testing.T) {
+		opt := WithStreamTransport(nil)
+		assert.IsType(t, *new(ClientOps), opt)
+	})
+
+	t.Run("test applying nil", func(t *testing.T) {
+		options := &ClientOptions{}
+		opt := WithStreamTransport(nil)
+		opt(options)
+		assert.True(t, options.streamTransport)
+		assert.Nil(t, options.streamOptions)
+	})
+
+	t.Run("test applying option", func(t *testing.T) {
+		options := &ClientOptions{}
+		streamOptions := DefaultStreamOptions()
+		opt := WithStreamTransport(streamOptions)
+		opt(options)
+		assert.True(t, options.streamTransport)
+		assert.Equal(t, streamOptions, options.streamOptions)
+	})
+}
@@ -7,20 +7,36 @@ const (
 	// defaultUserAgent is the default user agent for all requests
 	defaultUserAgent string = "go-nownodes: " + version
 
-	// apiVersion is the current NOWNodes API version
+	// apiPath & apiVersion are the current NOWNodes API path and version
+	apiPath     = "/api/"
 	apiVersion  = "v2"
 	nowNodesURL = "nownodes.io"
 
 	// Appends all requests with this protocol
 	httpProtocol = "https://"
+	wsProtocol   = "wss://"
 
 	// API header key for NOWNodes API
 	apiHeaderKey = "api-key"
 
+	// Error details
+	maxErrorBodyLength    = 512
+	notFoundMessageSuffix = " not found" // BlockBook messages of a missing resource (IE: Transaction 'abc' not found)
+	redactedValue         = "REDACTED"
+
+	// BlockBook defaults (the WebSocket defaults are different)
+	defaultAddressPageSize = 1000
+
 	// Coin specific values
 	bitcoinCashPrefix = "bitcoincash:"
 
+	// Fee conversions
+	bytesPerKilobyte = 1000
+	satoshisPerCoin  = 100000000
+
 	// Bitcoin transaction length
+	bitcoinBlockHashLength      = 64
+	bitcoinMaxBlockHeight       = 1<<31 - 1 // Heights are int32 on the nodes
 	bitcoinCashMaxAddressLength = 42
 	bitcoinMaxAddressLength     = 35
 	bitcoinMinAddressLength     = 26
@@ -30,6 +46,12 @@ const (
 	liteCoinMaxAddressLength    = 43
 	maxTxHexLengthOnSend        = 2000
 
+	// Extended public keys (base58 check encoded, BIP32 serialization)
+	base58Alphabet          = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+	extendedKeyChecksumSize = 4
+	extendedKeyDataOffset   = 45 // Version (4), depth (1), fingerprint (4), child number (4), chain code (32)
+	extendedKeyLength       = 78
+
 	// Blockchains
 	blockchainBCH        = "bch"
 	blockchainBSV        = "bsv"
@@ -42,13 +64,89 @@ const (
 	blockchainLTC        = "ltc"
 
 	// Routes
-	routeGetAddress = "/address/"
-	routeGetTx      = "/tx/"
-	routeSendTx     = "/sendtx/"
+	routeEstimateFee       = "/estimatefee/"
+	routeGetAddress        = "/address/"
+	routeGetBalanceHistory = "/balancehistory/"
+	routeGetBlock          = "/block/"
+	routeGetBlockHash      = "/block-index/"
+	routeGetTickers        = "/tickers/"
+	routeGetTickersList    = "/tickers-list/"
+	routeGetTx             = "/tx/"
+	routeGetUTXO           = "/utxo/"
+	routeGetXPub           = "/xpub/"
+	routeSendTx            = "/sendtx/"
+	routeStatus            = apiPath
+	routeWebSocket         = "/websocket"
+
+	// NodeAPI error codes (bitcoind)
+	rpcCodeAlreadyInChain   = -27
+	rpcCodeInvalidParameter = -8
+	rpcCodeMissingInputs    = -25
+	rpcCodeNotFound         = -5
+	rpcCodeVerifyRejected   = -26
+
+	// Blockbook WebSocket methods
+	streamMethodEstimateFee          = "estimateFee"
+	streamMethodGetAccountInfo       = "getAccountInfo"
+	streamMethodGetInfo              = "getInfo"
+	streamMethodGetTransaction       = "getTransaction"
+	streamMethodPing                 = "ping"
+	streamMethodSendTransaction      = "sendTransaction"
+	streamMethodSubscribeAddresses   = "subscribeAddresses"
+	streamMethodSubscribeNewBlock    = "subscribeNewBlock"
+	streamMethodUnsubscribeAddresses = "unsubscribeAddresses"
+	streamMethodUnsubscribeNewBlock  = "unsubscribeNewBlock"
+
+	// Blockbook WebSocket subscription names (one subscription per type per connection)
+	streamIDSeparator           = ":"
+	streamSubscriptionAddresses = "addresses"
+	streamSubscriptionNewBlock  = "newBlock"
+
+	// Max addresses of all address subscriptions (every change sends all addresses, about 1 MB for 20,000)
+	defaultMaxStreamAddresses = 20000
 
 	// NodeAPI methods
-	nodeMethodGetMempoolEntry = "getmempoolentry"
-	nodeMethodSendRawTx       = "sendrawtransaction"
+	nodeMethodDecodeRawTransaction  = "decoderawtransaction"
+	nodeMethodEstimateSmartFee      = "estimatesmartfee"
+	nodeMethodGetBlock              = "getblock"
+	nodeMethodGetBlockHeader        = "getblockheader"
+	nodeMethodGetBestBlockHash      = "getbestblockhash"
+	nodeMethodGetBlockchainInfo     = "getblockchaininfo"
+	nodeMethodGetBlockCount         = "getblockcount"
+	nodeMethodGetChainTips          = "getchaintips"
+	nodeMethodGetDifficulty         = "getdifficulty"
+	nodeMethodGetConnectionCount    = "getconnectioncount"
+	nodeMethodGetMempoolAncestors   = "getmempoolancestors"
+	nodeMethodGetMempoolDescendants = "getmempooldescendants"
+	nodeMethodGetMempoolEntry       = "getmempoolentry"
+	nodeMethodGetMempoolInfo        = "getmempoolinfo"
+	nodeMethodGetMiningInfo         = "getmininginfo"
+	nodeMethodGetNetworkInfo        = "getnetworkinfo"
+	nodeMethodGetPeerInfo           = "getpeerinfo"
+	nodeMethodGetRawMempool         = "getrawmempool"
+	nodeMethodGetRawTransaction     = "getrawtransaction"
+	nodeMethodGetTxOut              = "gettxout"
+	nodeMethodSendRawTx             = "sendrawtransaction"
+	nodeMethodTestMempoolAccept     = "testmempoolaccept"
+
+	// Ethereum NodeAPI methods
+	nodeMethodEthBlockNumber           = "eth_blockNumber"
+	nodeMethodEthCall                  = "eth_call"
+	nodeMethodEthEstimateGas           = "eth_estimateGas"
+	nodeMethodEthFeeHistory            = "eth_feeHistory"
+	nodeMethodEthGasPrice              = "eth_gasPrice"
+	nodeMethodEthGetBalance            = "eth_getBalance"
+	nodeMethodEthGetLogs               = "eth_getLogs"
+	nodeMethodEthGetTransactionByHash  = "eth_getTransactionByHash"
+	nodeMethodEthGetTransactionCount   = "eth_getTransactionCount"
+	nodeMethodEthGetTransactionReceipt = "eth_getTransactionReceipt"
+	nodeMethodEthSendRawTransaction    = "eth_sendRawTransaction"
+
+	// Ethereum values
+	ethereumHexPrefix = "0x"
+
+	// NodeAPI response keys
+	nodeResultKey = "result"
 )
 
 var (
@@ -65,11 +163,24 @@ var (
 		LTC,
 	}
 
+	// All blockchains including Ethereum (only supported by some BlockBook methods)
+	allBlockchainsWithETH = []Blockchain{
+		BCH,
+		BSV,
+		BTC,
+		BTCTestnet,
+		BTG,
+		DASH,
+		DOGE,
+		ETH,
+		LTC,
+	}
+
 	// Supported blockchains for the method GetTransaction()
-	getTransactionBlockchains = allBlockchains
+	getTransactionBlockchains = allBlockchainsWithETH
 
 	// Supported blockchains for the method GetAddress()
-	getAddressBlockchains = allBlockchains
+	getAddressBlockchains = allBlockchainsWithETH
 
 	// Supported blockchains for the method SendTransaction()
 	sendTransactionBlockchains = allBlockchains
@@ -79,4 +190,118 @@ var (
 
 	// Supported blockchains for the method GetMempoolEntry()
 	getMempoolEntryBlockchains = allBlockchains
+
+	// Supported blockchains for the method TestMempoolAccept() (BSV and DOGE nodes do not have this method)
+	testMempoolAcceptBlockchains = []Blockchain{BCH, BTC, BTCTestnet, BTG, DASH, LTC}
+
+	// Supported blockchains for the method GetMempoolInfo()
+	getMempoolInfoBlockchains = allBlockchains
+
+	// Supported blockchains for the method GetRawMempool()
+	getRawMempoolBlockchains = allBlockchains
+
+	// Supported blockchains for the method GetMempoolAncestors()
+	getMempoolAncestorsBlockchains = allBlockchains
+
+	// Supported blockchains for the method GetMempoolDescendants()
+	getMempoolDescendantsBlockchains = allBlockchains
+
+	// Supported blockchains for the method GetBlock()
+	getBlockBlockchains = allBlockchains
+
+	// Supported blockchains for the method GetBlockHash()
+	getBlockHashBlockchains = allBlockchains
+
+	// Supported blockchains for the method GetXPub()
+	getXPubBlockchains = allBlockchains
+
+	// Supported blockchains for the method GetUTXOs()
+	getUTXOsBlockchains = allBlockchains
+
+	// Supported blockchains for the method GetStatus()
+	getStatusBlockchains = allBlockchains
+
+	// Supported blockchains for the method GetBalanceHistory()
+	getBalanceHistoryBlockchains = allBlockchains
+
+	// Supported blockchains for the method GetTickers()
+	getTickersBlockchains = allBlockchains
+
+	// Supported blockchains for the method GetTickersList()
+	getTickersListBlockchains = allBlockchains
+
+	// Supported blockchains for the method Call() and CallRaw()
+	callBlockchains = allBlockchains
+
+	// Supported blockchains for the method NewBatch()
+	batchBlockchains = allBlockchains
+
+	// Supported blockchains for the method GetBlockchainInfo()
+	getBlockchainInfoBlockchains = allBlockchains
+
+	// Supported blockchains for the method GetBlockCount()
+	getBlockCountBlockchains = allBlockchains
+
+	// Supported blockchains for the method GetBestBlockHash()
+	getBestBlockHashBlockchains = allBlockchains
+
+	// Supported blockchains for the method GetChainTips()
+	getChainTipsBlockchains = allBlockchains
+
+	// Supported blockchains for the method GetDifficulty()
+	getDifficultyBlockchains = allBlockchains
+
+	// Supported blockchains for the method GetTxOut()
+	getTxOutBlockchains = allBlockchains
+
+	// Supported blockchains for the method GetNetworkInfo()
+	getNetworkInfoBlockchains = allBlockchains
+
+	// Supported blockchains for the method GetPeerInfo()
+	getPeerInfoBlockchains = allBlockchains
+
+	// Supported blockchains for the method GetConnectionCount()
+	getConnectionCountBlockchains = allBlockchains
+
+	// Supported blockchains for the method GetMiningInfo()
+	getMiningInfoBlockchains = allBlockchains
+
+	// Supported blockchains for the method NewStreamClient()
+	streamBlockchains = allBlockchains
+
+	// Supported blockchains for the method GetBlockHeader()
+	getBlockHeaderBlockchains = allBlockchains
+
+	// Supported blockchains for the method GetBlockRPC()
+	getBlockRPCBlockchains = allBlockchains
+
+	// Blockchains only accepting a bool (verbose) for the method GetBlockRPC() (no BlockVerbosityTxs)
+	getBlockRPCBoolVerbosityBlockchains = []Blockchain{DOGE}
+
+	// Supported blockchains for the method GetRawTransaction()
+	getRawTransactionBlockchains = allBlockchains
+
+	// Supported blockchains for the method DecodeRawTransaction()
+	decodeRawTransactionBlockchains = allBlockchains
+
+	// Supported blockchains for the method EstimateFee() (BlockBook)
+	estimateFeeBlockchains = allBlockchains
+
+	// Supported blockchains for the method EstimateFee() (Node API fallback)
+	estimateSmartFeeBlockchains = allBlockchains
+
+	// Supported blockchains for the EthereumService methods (eth_*)
+	ethereumBlockchains = []Blockchain{ETH}
+
+	// Known API error messages (lowercase prefixes) of a missing or unknown API key
+	unauthorizedMessages = []string{
+		"missing api-key",
+		"unknown api_key",
+	}
+
+	// Known API error messages (lowercase prefixes) of a rate limited request
+	rateLimitedMessages = []string{
+		"rate limit",
+		"too many requests",
+	}
 )
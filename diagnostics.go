@@ -0,0 +1,132 @@
+package nownodes
+
+import (
+	"context"
+)
+
+// NetworkInfo is the P2P networking state returned to the GetNetworkInfo request
+type NetworkInfo struct {
+	Connections     uint64            `json:"connections"`
+	ConnectionsIn   uint64            `json:"connections_in,omitempty"`  // BTC (v21+)
+	ConnectionsOut  uint64            `json:"connections_out,omitempty"` // BTC (v21+)
+	IncrementalFee  float64           `json:"incrementalfee,omitempty"`
+	LocalAddresses  []*LocalAddress   `json:"localaddresses"`
+	LocalRelay      bool              `json:"localrelay"`
+	LocalServices   string            `json:"localservices"`
+	NetworkActive   bool              `json:"networkactive"`
+	Networks        []*NetworkDetails `json:"networks"`
+	ProtocolVersion uint64            `json:"protocolversion"`
+	RelayFee        float64           `json:"relayfee"`
+	SubVersion      string            `json:"subversion"` // IE: /Satoshi:22.0.0/
+	TimeOffset      int64             `json:"timeoffset"`
+	Version         uint64            `json:"version"`
+	Warnings        NodeWarnings      `json:"warnings"` // A list on Bitcoin Core v28+
+}
+
+// NetworkDetails is the state of a network (ipv4, ipv6, onion...) returned in NetworkInfo
+type NetworkDetails struct {
+	Limited                   bool   `json:"limited"`
+	Name                      string `json:"name"` // IE: ipv4, ipv6, onion
+	Proxy                     string `json:"proxy"`
+	ProxyRandomizeCredentials bool   `json:"proxy_randomize_credentials"`
+	Reachable                 bool   `json:"reachable"`
+}
+
+// LocalAddress is an address the node is listening on returned in NetworkInfo
+type LocalAddress struct {
+	Address string `json:"address"`
+	Port    uint64 `json:"port"`
+	Score   int64  `json:"score"`
+}
+
+// PeerInfo is a connected peer returned to the GetPeerInfo request
+type PeerInfo struct {
+	Address        string  `json:"addr"`
+	AddressLocal   string  `json:"addrlocal,omitempty"`
+	BanScore       int64   `json:"banscore,omitempty"` // Older nodes
+	BytesReceived  uint64  `json:"bytesrecv"`
+	BytesSent      uint64  `json:"bytessent"`
+	ConnectionTime int64   `json:"conntime"`
+	ConnectionType string  `json:"connection_type,omitempty"` // BTC (v21+) IE: outbound-full-relay, block-relay-only, inbound
+	ID             int64   `json:"id"`
+	Inbound        bool    `json:"inbound"`
+	LastReceive    int64   `json:"lastrecv"`
+	LastSend       int64   `json:"lastsend"`
+	MinFeeFilter   float64 `json:"minfeefilter,omitempty"`
+	Network        string  `json:"network,omitempty"` // BTC (v22+) IE: ipv4, ipv6, onion
+	PingTime       float64 `json:"pingtime,omitempty"`
+	PingWait       float64 `json:"pingwait,omitempty"`
+	RelayTxes      bool    `json:"relaytxes"`
+	Services       string  `json:"services"`
+	StartingHeight int64   `json:"startingheight"`
+	SubVersion     string  `json:"subver"`
+	SyncedBlocks   int64   `json:"synced_blocks"`
+	SyncedHeaders  int64   `json:"synced_headers"`
+	TimeOffset     int64   `json:"timeoffset"`
+	Version        uint64  `json:"version"`
+	WhiteListed    bool    `json:"whitelisted,omitempty"` // Older nodes
+}
+
+// MiningInfo is the mining state returned to the GetMiningInfo request
+type MiningInfo struct {
+	Blocks             uint64       `json:"blocks"`
+	Chain              string       `json:"chain"` // IE: main, test
+	CurrentBlockTx     uint64       `json:"currentblocktx,omitempty"`
+	CurrentBlockWeight uint64       `json:"currentblockweight,omitempty"`
+	Difficulty         float64      `json:"difficulty"`
+	NetworkHashPS      float64      `json:"networkhashps"`
+	PooledTx           uint64       `json:"pooledtx"`
+	Warnings           NodeWarnings `json:"warnings"` // A list on Bitcoin Core v28+
+}
+
+// GetNetworkInfo will get the P2P networking state of the node
+//
+// This method supports the following chains: BCH, BSV, BTC, BTCTestnet, BTG, DASH, DOGE, LTC
+func (c *Client) GetNetworkInfo(ctx context.Context, chain Blockchain) (*NetworkInfo, error) {
+	info := new(NetworkInfo)
+	if err := c.call(
+		ctx, getNetworkInfoBlockchains, chain, nodeMethodGetNetworkInfo, nil, info,
+	); err != nil {
+		return nil, err
+	}
+	return info, nil
+}
+
+// GetPeerInfo will get the peers connected to the node
+//
+// This method supports the following chains: BCH, BSV, BTC, BTCTestnet, BTG, DASH, DOGE, LTC
+func (c *Client) GetPeerInfo(ctx context.Context, chain Blockchain) ([]*PeerInfo, error) {
+	var peers []*PeerInfo
+	if err := c.call(
+		ctx, getPeerInfoBlockchains, chain, nodeMethodGetPeerInfo, nil, &peers,
+	); err != nil {
+		return nil, err
+	}
+	return peers, nil
+}
+
+// GetConnectionCount will get the number of connections (peers) of the node
+//
+// This method supports the following chains: BCH, BSV, BTC, BTCTestnet, BTG, DASH, DOGE, LTC
+func (c *Client) GetConnectionCount(ctx context.Context, chain Blockchain) (uint64, error) {
+	var count uint64
+	if err := c.call(
+		ctx, getConnectionCountBlockchains, chain, nodeMethodGetConnectionCount, nil, &count,
+	); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// GetMiningInfo will get the mining related state of the node
+//
+// This method supports the following chains: BCH, BSV, BTC, BTCTestnet, BTG, DASH, DOGE, LTC
+func (c *Client) GetMiningInfo(ctx context.Context, chain Blockchain) (*MiningInfo, error) {
+	info := new(MiningInfo)
+	if err := c.call(
+		ctx, getMiningInfoBlockchains, chain, nodeMethodGetMiningInfo, nil, info,
+	); err != nil {
+		return nil, err
+	}
+	return info, nil
+}
@@ -0,0 +1,211 @@
+package nownodes
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// diagnosticsResults are the NodeAPI results for the diagnostics methods
+var diagnosticsResults = map[string]string{
+	nodeMethodGetConnectionCount: `10`,
+	nodeMethodGetMiningInfo:      `{"blocks":723772,"currentblockweight":3993813,"currentblocktx":2654,"difficulty":26690525287405.5,"networkhashps":1.918176143535829e+20,"pooledtx":4186,"chain":"main","warnings":""}`,
+	nodeMethodGetNetworkInfo:     `{"version":220000,"subversion":"/Satoshi:22.0.0/","protocolversion":70016,"localservices":"0000000000000409","localrelay":true,"timeoffset":0,"networkactive":true,"connections":10,"connections_in":0,"connections_out":10,"networks":[{"name":"ipv4","limited":false,"reachable":true,"proxy":"","proxy_randomize_credentials":false},{"name":"onion","limited":true,"reachable":false,"proxy":"","proxy_randomize_credentials":false}],"relayfee":0.00001000,"incrementalfee":0.00001000,"localaddresses":[],"warnings":""}`,
+	nodeMethodGetPeerInfo:        `[{"id":3,"addr":"203.0.113.7:8333","addrlocal":"198.51.100.2:43728","network":"ipv4","services":"0000000000000409","relaytxes":true,"lastsend":1644145370,"lastrecv":1644145371,"bytessent":1257488,"bytesrecv":83654311,"conntime":1644051226,"timeoffset":0,"pingtime":0.091274,"minping":0.087641,"version":70016,"subver":"/Satoshi:22.0.0/","inbound":false,"connection_type":"outbound-full-relay","startingheight":723611,"synced_headers":723772,"synced_blocks":723772,"minfeefilter":0.00001000},{"id":7,"addr":"192.0.2.44:8333","network":"ipv4","services":"0000000000000409","relaytxes":false,"lastsend":1644145366,"lastrecv":1644145366,"bytessent":51234,"bytesrecv":1384225,"conntime":1644051301,"timeoffset":-1,"pingtime":0.154302,"version":70016,"subver":"/Satoshi:0.21.1/","inbound":false,"connection_type":"block-relay-only","startingheight":723611,"synced_headers":723772,"synced_blocks":723772}]`,
+}
+
+func TestClient_GetNetworkInfo(t *testing.T) {
+	t.Parallel()
+
+	t.Run("valid cases", func(t *testing.T) {
+		c := NewClient(WithAPIKey(testKey), WithHTTPClient(&nodeMethodResponse{results: diagnosticsResults}))
+		for _, chain := range getNetworkInfoBlockchains {
+			t.Run("chain "+chain.String()+": GetNetworkInfo()", func(t *testing.T) {
+				info, err := c.GetNetworkInfo(context.Background(), chain)
+				require.NoError(t, err)
+				require.NotNil(t, info)
+				assert.Equal(t, uint64(220000), info.Version)
+				assert.Equal(t, "/Satoshi:22.0.0/", info.SubVersion)
+				assert.Equal(t, uint64(10), info.Connections)
+				assert.Equal(t, uint64(10), info.ConnectionsOut)
+				assert.True(t, info.NetworkActive)
+				assert.InDelta(t, 0.00001, info.RelayFee, 0.000000001)
+				require.Len(t, info.Networks, 2)
+				assert.Equal(t, "ipv4", info.Networks[0].Name)
+				assert.True(t, info.Networks[0].Reachable)
+				assert.Empty(t, info.LocalAddresses)
+				assert.Empty(t, info.Warnings)
+			})
+		}
+	})
+
+	t.Run("warnings list (v28+)", func(t *testing.T) {
+		c := NewClient(WithHTTPClient(&nodeMethodResponse{results: map[string]string{
+			nodeMethodGetNetworkInfo: `{"version":280000,"subversion":"/Satoshi:28.0.0/","warnings":["This is a pre-release test build"]}`,
+		}}))
+		info, err := c.GetNetworkInfo(context.Background(), BTC)
+		require.NoError(t, err)
+		require.NotNil(t, info)
+		assert.Equal(t, NodeWarnings{"This is a pre-release test build"}, info.Warnings)
+	})
+
+	t.Run("unsupported chain", func(t *testing.T) {
+		c := NewClient(WithHTTPClient(&nodeMethodResponse{results: diagnosticsResults}))
+		info, err := c.GetNetworkInfo(context.Background(), ETH)
+		require.Error(t, err)
+		require.Nil(t, info)
+		assert.ErrorIs(t, err, ErrUnsupportedBlockchain)
+	})
+
+	t.Run("error cases", func(t *testing.T) {
+		for _, chain := range getNetworkInfoBlockchains {
+			t.Run("chain "+chain.String()+": method not found", func(t *testing.T) {
+				c := NewClient(WithHTTPClient(&nodeMethodResponse{}))
+				info, err := c.GetNetworkInfo(context.Background(), chain)
+				require.Error(t, err)
+				require.Nil(t, info)
+			})
+
+			t.Run("chain "+chain.String()+": http req error", func(t *testing.T) {
+				c := NewClient(WithHTTPClient(&errorDoReqErr{}))
+				info, err := c.GetNetworkInfo(context.Background(), chain)
+				require.Error(t, err)
+				require.Nil(t, info)
+			})
+
+			t.Run("chain "+chain.String()+": invalid json response", func(t *testing.T) {
+				c := NewClient(WithHTTPClient(&errorBadJSONResponse{}))
+				info, err := c.GetNetworkInfo(context.Background(), chain)
+				require.Error(t, err)
+				require.Nil(t, info)
+			})
+		}
+	})
+}
+
+func TestClient_GetPeerInfo(t *testing.T) {
+	t.Parallel()
+
+	t.Run("valid cases", func(t *testing.T) {
+		c := NewClient(WithAPIKey(testKey), WithHTTPClient(&nodeMethodResponse{results: diagnosticsResults}))
+		for _, chain := range getPeerInfoBlockchains {
+			t.Run("chain "+chain.String()+": GetPeerInfo()", func(t *testing.T) {
+				peers, err := c.GetPeerInfo(context.Background(), chain)
+				require.NoError(t, err)
+				require.Len(t, peers, 2)
+				assert.Equal(t, int64(3), peers[0].ID)
+				assert.Equal(t, "203.0.113.7:8333", peers[0].Address)
+				assert.Equal(t, "outbound-full-relay", peers[0].ConnectionType)
+				assert.True(t, peers[0].RelayTxes)
+				assert.Equal(t, uint64(83654311), peers[0].BytesReceived)
+				assert.InDelta(t, 0.091274, peers[0].PingTime, 0.0000001)
+				assert.Equal(t, int64(723772), peers[0].SyncedBlocks)
+				assert.Equal(t, "block-relay-only", peers[1].ConnectionType)
+				assert.False(t, peers[1].RelayTxes)
+				assert.Equal(t, int64(-1), peers[1].TimeOffset)
+			})
+		}
+	})
+
+	t.Run("error cases", func(t *testing.T) {
+		c := NewClient(WithHTTPClient(&nodeMethodResponse{}))
+		peers, err := c.GetPeerInfo(context.Background(), BSV)
+		require.Error(t, err)
+		require.Nil(t, peers)
+
+		c = NewClient(WithHTTPClient(&nodeMethodResponse{results: diagnosticsResults}))
+		peers, err = c.GetPeerInfo(context.Background(), ETH)
+		require.Nil(t, peers)
+		assert.ErrorIs(t, err, ErrUnsupportedBlockchain)
+	})
+}
+
+func TestClient_GetConnectionCount(t *testing.T) {
+	t.Parallel()
+
+	t.Run("valid cases", func(t *testing.T) {
+		c := NewClient(WithAPIKey(testKey), WithHTTPClient(&nodeMethodResponse{results: diagnosticsResults}))
+		for _, chain := range getConnectionCountBlockchains {
+			count, err := c.GetConnectionCount(context.Background(), chain)
+			require.NoError(t, err)
+			assert.Equal(t, uint64(10), count)
+		}
+	})
+
+	t.Run("error cases", func(t *testing.T) {
+		c := NewClient(WithHTTPClient(&nodeMethodResponse{}))
+		count, err := c.GetConnectionCount(context.Background(), BSV)
+		require.Error(t, err)
+		assert.Equal(t, uint64(0), count)
+
+		c = NewClient(WithHTTPClient(&nodeMethodResponse{results: diagnosticsResults}))
+		_, err = c.GetConnectionCount(context.Background(), ETH)
+		assert.ErrorIs(t, err, ErrUnsupportedBlockchain)
+	})
+}
+
+func TestClient_GetMiningInfo(t *testing.T) {
+	t.Parallel()
+
+	t.Run("valid cases", func(t *testing.T) {
+		c := NewClient(WithAPIKey(testKey), WithHTTPClient(&nodeMethodResponse{results: diagnosticsResults}))
+		for _, chain := range getMiningInfoBlockchains {
+			info, err := c.GetMiningInfo(context.Background(), chain)
+			require.NoError(t, err)
+			require.NotNil(t, info)
+			assert.Equal(t, uint64(723772), info.Blocks)
+			assert.Equal(t, "main", info.Chain)
+			assert.Equal(t, uint64(4186), info.PooledTx)
+			assert.Equal(t, uint64(2654), info.CurrentBlockTx)
+			assert.InDelta(t, 1.918176143535829e+20, info.NetworkHashPS, 1e+6)
+			assert.Empty(t, info.Warnings)
+		}
+	})
+
+	t.Run("warnings list (v28+)", func(t *testing.T) {
+		c := NewClient(WithHTTPClient(&nodeMethodResponse{results: map[string]string{
+			nodeMethodGetMiningInfo: `{"blocks":723772,"chain":"main","warnings":["This is a pre-release test build"]}`,
+		}}))
+		info, err := c.GetMiningInfo(context.Background(), BTC)
+		require.NoError(t, err)
+		require.NotNil(t, info)
+		assert.Equal(t, NodeWarnings{"This is a pre-release test build"}, info.Warnings)
+	})
+
+	t.Run("error cases", func(t *testing.T) {
+		c := NewClient(WithHTTPClient(&nodeMethodResponse{}))
+		info, err := c.GetMiningInfo(context.Background(), BSV)
+		require.Error(t, err)
+		require.Nil(t, info)
+
+		c = NewClient(WithHTTPClient(&nodeMethodResponse{results: diagnosticsResults}))
+		info, err = c.GetMiningInfo(context.Background(), ETH)
+		require.Nil(t, info)
+		assert.ErrorIs(t, err, ErrUnsupportedBlockchain)
+	})
+}
+
+func ExampleClient_GetNetworkInfo() {
+	c := NewClient(WithHTTPClient(&nodeMethodResponse{results: diagnosticsResults}))
+	info, _ := c.GetNetworkInfo(context.Background(), BTC)
+	fmt.Printf("node: %s connections: %d", info.SubVersion, info.Connections)
+	// Output:node: /Satoshi:22.0.0/ connections: 10
+}
+
+func ExampleClient_GetConnectionCount() {
+	c := NewClient(WithHTTPClient(&nodeMethodResponse{results: diagnosticsResults}))
+	count, _ := c.GetConnectionCount(context.Background(), BTC)
+	fmt.Printf("connections: %d", count)
+	// Output:connections: 10
+}
+
+func BenchmarkClient_GetPeerInfo(b *testing.B) {
+	c := NewClient(WithHTTPClient(&nodeMethodResponse{results: diagnosticsResults}))
+	ctx := context.Background()
+	for i := 0; i < b.N; i++ {
+		_, _ = c.GetPeerInfo(ctx, BTC)
+	}
+}
@@ -5,11 +5,80 @@ import "errors"
 // ErrInvalidTxID is when the tx id is missing or invalid
 var ErrInvalidTxID = errors.New("missing or invalid tx id")
 
+// ErrTxOutNotFound is when the transaction output is spent or does not exist
+var ErrTxOutNotFound = errors.New("transaction output is spent or not found")
+
 // ErrInvalidTxHex is when the tx hex is missing or invalid
 var ErrInvalidTxHex = errors.New("missing or invalid tx hex")
 
+// ErrTxNotFound is when the transaction (or receipt) does not exist or is not mined yet
+var ErrTxNotFound = errors.New("transaction not found")
+
+// ErrInvalidHexQuantity is when a hex quantity (IE: 0x1a) is missing or invalid
+var ErrInvalidHexQuantity = errors.New("missing or invalid hex quantity")
+
 // ErrInvalidAddress is when the address is missing or invalid
 var ErrInvalidAddress = errors.New("missing or invalid address")
 
+// ErrInvalidXPub is when the extended public key is missing or invalid
+var ErrInvalidXPub = errors.New("missing or invalid extended public key")
+
+// ErrInvalidBlock is when the block hash or height is missing or invalid
+var ErrInvalidBlock = errors.New("missing or invalid block hash or height")
+
+// ErrInvalidVerbosity is when the verbosity level is invalid (or the writer is missing for the raw block)
+var ErrInvalidVerbosity = errors.New("invalid verbosity level or missing writer")
+
+// ErrInvalidConfirmationTarget is when the confirmation target (in blocks) is missing or invalid
+var ErrInvalidConfirmationTarget = errors.New("missing or invalid confirmation target")
+
+// ErrFeeEstimateUnavailable is when no fee estimate could be produced
+var ErrFeeEstimateUnavailable = errors.New("fee estimate is unavailable")
+
+// ErrInvalidMethod is when the NodeAPI method is missing or invalid
+var ErrInvalidMethod = errors.New("missing or invalid method")
+
+// ErrMissingBatchResponse is when the NodeAPI did not return a response for a call in a batch
+var ErrMissingBatchResponse = errors.New("missing response for batch call")
+
+// ErrMissingMempoolAcceptResult is when the NodeAPI did not return exactly one result for the dry run
+var ErrMissingMempoolAcceptResult = errors.New("missing mempool accept result")
+
+// ErrTooManyAddresses is when the address subscriptions would exceed the max addresses (see StreamOptions)
+var ErrTooManyAddresses = errors.New("too many subscribed addresses")
+
+// ErrStreamClosed is when the WebSocket stream was closed
+var ErrStreamClosed = errors.New("stream is closed")
+
+// ErrStreamDisconnected is when the WebSocket connection dropped before a response was received
+var ErrStreamDisconnected = errors.New("stream disconnected")
+
 // ErrUnsupportedBlockchain is when the given blockchain is not supported by the method
 var ErrUnsupportedBlockchain = errors.New("unsupported blockchain for this method")
+
+// ErrNotFound is when the API returns a not found error (status: 404 or a BlockBook "... not found" message)
+var ErrNotFound = errors.New("not found")
+
+// ErrRateLimited is when the API rate limit was reached (status: 429 or a rate limit message)
+var ErrRateLimited = errors.New("rate limited")
+
+// ErrUnauthorized is when the API key is missing or invalid (status: 401 or 403, or a NOWNodes api-key message)
+var ErrUnauthorized = errors.New("unauthorized")
+
+// ErrRPCAlreadyInChain is when the transaction is already in the chain (code: -27)
+var ErrRPCAlreadyInChain = errors.New("transaction already in chain")
+
+// ErrRPCInvalidParameter is when a parameter is invalid (code: -8)
+var ErrRPCInvalidParameter = errors.New("invalid parameter")
+
+// ErrRPCMissingInputs is when the transaction inputs are missing or spent (code: -25)
+var ErrRPCMissingInputs = errors.New("missing or spent inputs")
+
+// ErrRPCNotFound is when the transaction, block or address was not found (code: -5)
+var ErrRPCNotFound = errors.New("invalid address or key or not found")
+
+// ErrRPCTxAlreadyKnown is when the node already knows the transaction (code: -26 txn-already-known/txn-already-in-mempool or -27 already in the mempool)
+var ErrRPCTxAlreadyKnown = errors.New("transaction already known")
+
+// ErrRPCVerifyRejected is when the transaction was rejected by the network rules (code: -26)
+var ErrRPCVerifyRejected = errors.New("transaction rejected")
@@ -0,0 +1,490 @@
+package nownodes
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Ethereum block tags (any block can also be used with EthBlockTag())
+const (
+	EthBlockEarliest = "earliest" // Genesis block
+	EthBlockLatest   = "latest"   // Latest mined block (default)
+	EthBlockPending  = "pending"  // Pending state (includes the mempool)
+)
+
+// EthereumClient is used for the Ethereum NodeAPI (JSON-RPC) requests (eth_* methods)
+type EthereumClient struct {
+	chain  Blockchain // Ethereum blockchain for all requests
+	client *Client    // Client used for the requests
+}
+
+// EthCallMsg is the message for the Call and EstimateGas requests
+type EthCallMsg struct {
+	Data     string   `json:"data,omitempty"` // Input data (hex)
+	From     string   `json:"from,omitempty"`
+	Gas      *big.Int `json:"gas,omitempty"`
+	GasPrice *big.Int `json:"gasPrice,omitempty"`
+	To       string   `json:"to,omitempty"` // Empty for a contract creation
+	Value    *big.Int `json:"value,omitempty"`
+}
+
+// EthTransaction is the transaction returned to the GetTransactionByHash request
+type EthTransaction struct {
+	BlockHash            string   `json:"blockHash"`   // Empty if pending
+	BlockNumber          *big.Int `json:"blockNumber"` // Nil if pending
+	ChainID              *big.Int `json:"chainId,omitempty"`
+	From                 string   `json:"from"`
+	Gas                  *big.Int `json:"gas"`
+	GasPrice             *big.Int `json:"gasPrice"`
+	Hash                 string   `json:"hash"`
+	Input                string   `json:"input"`
+	MaxFeePerGas         *big.Int `json:"maxFeePerGas,omitempty"`         // EIP-1559
+	MaxPriorityFeePerGas *big.Int `json:"maxPriorityFeePerGas,omitempty"` // EIP-1559
+	Nonce                *big.Int `json:"nonce"`
+	R                    string   `json:"r"`
+	S                    string   `json:"s"`
+	To                   string   `json:"to"` // Empty for a contract creation
+	TransactionIndex     *big.Int `json:"transactionIndex"`
+	Type                 *big.Int `json:"type"`
+	V                    string   `json:"v"`
+	Value                *big.Int `json:"value"` // In wei
+}
+
+// EthReceipt is the receipt returned to the GetTransactionReceipt request
+type EthReceipt struct {
+	BlockHash         string            `json:"blockHash"`
+	BlockNumber       *big.Int          `json:"blockNumber"`
+	ContractAddress   string            `json:"contractAddress"` // Only for a contract creation
+	CumulativeGasUsed *big.Int          `json:"cumulativeGasUsed"`
+	EffectiveGasPrice *big.Int          `json:"effectiveGasPrice"`
+	From              string            `json:"from"`
+	GasUsed           *big.Int          `json:"gasUsed"`
+	Logs              []*EthLog         `json:"logs"`
+	LogsBloom         string            `json:"logsBloom"`
+	Root              string            `json:"root,omitempty"` // Only before Byzantium (instead of the status)
+	Status            *EthereumTxStatus `json:"status"`         // EthereumTxSuccess or EthereumTxFailure (nil before Byzantium)
+	To                string            `json:"to"`
+	TransactionHash   string            `json:"transactionHash"`
+	TransactionIndex  *big.Int          `json:"transactionIndex"`
+	Type              *big.Int          `json:"type"`
+}
+
+// EthLog is a log (event) returned to the GetLogs and GetTransactionReceipt requests
+type EthLog struct {
+	Address          string   `json:"address"`
+	BlockHash        string   `json:"blockHash"`
+	BlockNumber      *big.Int `json:"blockNumber"`
+	Data             string   `json:"data"`
+	LogIndex         *big.Int `json:"logIndex"`
+	Removed          bool     `json:"removed"` // Removed by a chain reorganization
+	Topics           []string `json:"topics"`
+	TransactionHash  string   `json:"transactionHash"`
+	TransactionIndex *big.Int `json:"transactionIndex"`
+}
+
+// EthLogFilter is the filter for the GetLogs request
+//
+// Use either BlockHash or a block range (FromBlock & ToBlock)
+type EthLogFilter struct {
+	Addresses []string   `json:"address,omitempty"`
+	BlockHash string     `json:"blockHash,omitempty"`
+	FromBlock string     `json:"fromBlock,omitempty"` // Block tag (IE: EthBlockTag(14093445) or EthBlockLatest)
+	ToBlock   string     `json:"toBlock,omitempty"`   // Block tag (IE: EthBlockTag(14093445) or EthBlockLatest)
+	Topics    [][]string `json:"topics,omitempty"`    // Topics by position (a nil position matches any topic)
+}
+
+// EthFeeHistory is the fee history returned to the FeeHistory request
+type EthFeeHistory struct {
+	BaseFeePerGas []*big.Int   `json:"baseFeePerGas"` // One more than the block count (includes the next block)
+	GasUsedRatio  []float64    `json:"gasUsedRatio"`
+	OldestBlock   *big.Int     `json:"oldestBlock"`
+	Reward        [][]*big.Int `json:"reward,omitempty"` // Only if reward percentiles were requested
+}
+
+// Ethereum will return the client for the Ethereum NodeAPI requests (eth_* methods)
+//
+// This method supports the following chains: ETH
+func (c *Client) Ethereum() EthereumService {
+	return &EthereumClient{
+		chain:  ETH,
+		client: c,
+	}
+}
+
+// EthBlockTag will return the block tag for the given block number (IE: 0xd70c85)
+func EthBlockTag(blockNumber uint64) string {
+	return ethereumHexPrefix + strconv.FormatUint(blockNumber, 16)
+}
+
+// BlockNumber will get the number of the latest block
+func (e *EthereumClient) BlockNumber(ctx context.Context) (*big.Int, error) {
+	return e.quantity(ctx, nodeMethodEthBlockNumber, nil)
+}
+
+// GasPrice will get the current gas price (in wei)
+func (e *EthereumClient) GasPrice(ctx context.Context) (*big.Int, error) {
+	return e.quantity(ctx, nodeMethodEthGasPrice, nil)
+}
+
+// GetBalance will get the balance (in wei) of the address at the given block
+//
+// param: block is optional (empty will use EthBlockLatest)
+func (e *EthereumClient) GetBalance(ctx context.Context, address, block string) (*big.Int, error) {
+
+	// Validate the input
+	if !e.chain.ValidateAddress(address) {
+		return nil, ErrInvalidAddress
+	}
+
+	return e.quantity(ctx, nodeMethodEthGetBalance, []interface{}{address, ethBlock(block)})
+}
+
+// GetTransactionCount will get the number of transactions sent from the address (the nonce) at the given block
+//
+// param: block is optional (empty will use EthBlockLatest, use EthBlockPending for the next nonce)
+func (e *EthereumClient) GetTransactionCount(ctx context.Context, address, block string) (*big.Int, error) {
+
+	// Validate the input
+	if !e.chain.ValidateAddress(address) {
+		return nil, ErrInvalidAddress
+	}
+
+	return e.quantity(ctx, nodeMethodEthGetTransactionCount, []interface{}{address, ethBlock(block)})
+}
+
+// GetTransactionByHash will get the transaction by a given hash
+//
+// ErrTxNotFound is returned if the transaction does not exist
+func (e *EthereumClient) GetTransactionByHash(ctx context.Context, hash string) (*EthTransaction, error) {
+
+	// Validate the input
+	if !e.chain.ValidateTxID(hash) {
+		return nil, ErrInvalidTxID
+	}
+
+	var tx *EthTransaction
+	if err := e.client.call(
+		ctx, ethereumBlockchains, e.chain, nodeMethodEthGetTransactionByHash, []interface{}{hash}, &tx,
+	); err != nil {
+		return nil, err
+	} else if tx == nil {
+		return nil, ErrTxNotFound
+	}
+	return tx, nil
+}
+
+// GetTransactionReceipt will get the receipt of a (mined) transaction by a given hash
+//
+// ErrTxNotFound is returned if the transaction does not exist or is not mined yet
+func (e *EthereumClient) GetTransactionReceipt(ctx context.Context, hash string) (*EthReceipt, error) {
+
+	// Validate the input
+	if !e.chain.ValidateTxID(hash) {
+		return nil, ErrInvalidTxID
+	}
+
+	var receipt *EthReceipt
+	if err := e.client.call(
+		ctx, ethereumBlockchains, e.chain, nodeMethodEthGetTransactionReceipt, []interface{}{hash}, &receipt,
+	); err != nil {
+		return nil, err
+	} else if receipt == nil {
+		return nil, ErrTxNotFound
+	}
+	return receipt, nil
+}
+
+// Call will execute the message (without creating a transaction) and return the result (hex)
+//
+// param: block is optional (empty will use EthBlockLatest)
+func (e *EthereumClient) Call(ctx context.Context, msg *EthCallMsg, block string) (string, error) {
+
+	// Validate the input
+	if msg == nil || !e.chain.ValidateAddress(msg.To) {
+		return "", ErrInvalidAddress
+	}
+
+	var result string
+	if err := e.client.call(
+		ctx, ethereumBlockchains, e.chain, nodeMethodEthCall, []interface{}{msg, ethBlock(block)}, &result,
+	); err != nil {
+		return "", err
+	}
+	return result, nil
+}
+
+// EstimateGas will estimate the gas needed for the message to execute
+func (e *EthereumClient) EstimateGas(ctx context.Context, msg *EthCallMsg) (*big.Int, error) {
+
+	// Validate the input (the recipient is empty for a contract creation)
+	if msg == nil || (len(msg.To) > 0 && !e.chain.ValidateAddress(msg.To)) {
+		return nil, ErrInvalidAddress
+	}
+
+	return e.quantity(ctx, nodeMethodEthEstimateGas, []interface{}{msg})
+}
+
+// FeeHistory will get the base fees, gas used ratios and (optionally) the priority fee percentiles
+// of the given number of blocks ending with the newest block
+//
+// param: newestBlock is optional (empty will use EthBlockLatest)
+// param: rewardPercentiles is optional (IE: 25, 50, 75)
+func (e *EthereumClient) FeeHistory(ctx context.Context, blockCount uint64, newestBlock string,
+	rewardPercentiles []float64) (*EthFeeHistory, error) {
+
+	// Validate the input
+	if blockCount == 0 {
+		return nil, ErrInvalidBlock
+	}
+
+	// The percentiles are always sent (some nodes require the param)
+	if rewardPercentiles == nil {
+		rewardPercentiles = []float64{}
+	}
+
+	history := new(EthFeeHistory)
+	if err := e.client.call(
+		ctx, ethereumBlockchains, e.chain, nodeMethodEthFeeHistory,
+		[]interface{}{EthBlockTag(blockCount), ethBlock(newestBlock), rewardPercentiles}, history, // Count as a hex quantity
+	); err != nil {
+		return nil, err
+	}
+	return history, nil
+}
+
+// GetLogs will get the logs (events) matching the filter
+func (e *EthereumClient) GetLogs(ctx context.Context, filter *EthLogFilter) ([]*EthLog, error) {
+
+	// Validate the input
+	if filter == nil {
+		filter = new(EthLogFilter)
+	}
+	for _, address := range filter.Addresses {
+		if !e.chain.ValidateAddress(address) {
+			return nil, ErrInvalidAddress
+		}
+	}
+
+	logs := make([]*EthLog, 0)
+	if err := e.client.call(
+		ctx, ethereumBlockchains, e.chain, nodeMethodEthGetLogs, []interface{}{filter}, &logs,
+	); err != nil {
+		return nil, err
+	}
+	return logs, nil
+}
+
+// SendRawTransaction will broadcast the signed transaction (hex) and return the transaction hash
+func (e *EthereumClient) SendRawTransaction(ctx context.Context, txHex string) (string, error) {
+
+	// Validate the input
+	if !isEthereumHex(txHex) {
+		return "", ErrInvalidTxHex
+	}
+
+	var hash string
+	if err := e.client.call(
+		ctx, ethereumBlockchains, e.chain, nodeMethodEthSendRawTransaction, []interface{}{txHex}, &hash,
+	); err != nil {
+		return "", err
+	}
+	return hash, nil
+}
+
+// quantity will invoke the method and decode the hex quantity result
+func (e *EthereumClient) quantity(ctx context.Context, method string, params []interface{}) (*big.Int, error) {
+	var result string
+	if err := e.client.call(
+		ctx, ethereumBlockchains, e.chain, method, params, &result,
+	); err != nil {
+		return nil, err
+	}
+	return parseHexQuantity(result)
+}
+
+// ethBlock will return the block tag (EthBlockLatest if empty)
+func ethBlock(block string) string {
+	if len(block) == 0 {
+		return EthBlockLatest
+	}
+	return block
+}
+
+// isEthereumHex will return true if the data is 0x prefixed hex (IE: a signed transaction)
+func isEthereumHex(data string) bool {
+	if !strings.HasPrefix(data, ethereumHexPrefix) || len(data) == len(ethereumHexPrefix) {
+		return false
+	}
+	for _, r := range data[len(ethereumHexPrefix):] {
+		if !strings.ContainsRune("0123456789abcdefABCDEF", r) {
+			return false
+		}
+	}
+	return true
+}
+
+// parseHexQuantity will decode the hex quantity (IE: 0x1a) into a *big.Int
+func parseHexQuantity(quantity string) (*big.Int, error) {
+	if !isEthereumHex(quantity) {
+		return nil, ErrInvalidHexQuantity
+	}
+	value, ok := new(big.Int).SetString(quantity[len(ethereumHexPrefix):], 16)
+	if !ok {
+		return nil, ErrInvalidHexQuantity
+	}
+	return value, nil
+}
+
+// hexBig is a *big.Int encoded as a hex quantity (IE: 0x1a) in JSON
+type hexBig big.Int
+
+// MarshalJSON will encode the value as a hex quantity
+func (h *hexBig) MarshalJSON() ([]byte, error) {
+	return json.Marshal(ethereumHexPrefix + (*big.Int)(h).Text(16))
+}
+
+// UnmarshalJSON will decode the hex quantity
+func (h *hexBig) UnmarshalJSON(data []byte) error {
+	var quantity string
+	if err := json.Unmarshal(data, &quantity); err != nil {
+		return err
+	}
+	value, err := parseHexQuantity(quantity)
+	if err != nil {
+		return err
+	}
+	(*big.Int)(h).Set(value)
+	return nil
+}
+
+// hexBigs will convert the hex quantities into *big.Int values
+func hexBigs(values []*hexBig) []*big.Int {
+	if values == nil {
+		return nil
+	}
+	converted := make([]*big.Int, len(values))
+	for i, value := range values {
+		converted[i] = (*big.Int)(value)
+	}
+	return converted
+}
+
+// MarshalJSON will encode the message (using hex quantities)
+func (m *EthCallMsg) MarshalJSON() ([]byte, error) {
+	type alias EthCallMsg
+	return json.Marshal(&struct {
+		*alias
+		Gas      *hexBig `json:"gas,omitempty"`
+		GasPrice *hexBig `json:"gasPrice,omitempty"`
+		Value    *hexBig `json:"value,omitempty"`
+	}{
+		alias:    (*alias)(m),
+		Gas:      (*hexBig)(m.Gas),
+		GasPrice: (*hexBig)(m.GasPrice),
+		Value:    (*hexBig)(m.Value),
+	})
+}
+
+// UnmarshalJSON will decode the transaction (hex quantities)
+func (t *EthTransaction) UnmarshalJSON(data []byte) error {
+	type alias EthTransaction
+	raw := &struct {
+		*alias
+		BlockNumber          *hexBig `json:"blockNumber"`
+		ChainID              *hexBig `json:"chainId"`
+		Gas                  *hexBig `json:"gas"`
+		GasPrice             *hexBig `json:"gasPrice"`
+		MaxFeePerGas         *hexBig `json:"maxFeePerGas"`
+		MaxPriorityFeePerGas *hexBig `json:"maxPriorityFeePerGas"`
+		Nonce                *hexBig `json:"nonce"`
+		TransactionIndex     *hexBig `json:"transactionIndex"`
+		Type                 *hexBig `json:"type"`
+		Value                *hexBig `json:"value"`
+	}{alias: (*alias)(t)}
+	if err := json.Unmarshal(data, raw); err != nil {
+		return err
+	}
+	t.BlockNumber = (*big.Int)(raw.BlockNumber)
+	t.ChainID = (*big.Int)(raw.ChainID)
+	t.Gas = (*big.Int)(raw.Gas)
+	t.GasPrice = (*big.Int)(raw.GasPrice)
+	t.MaxFeePerGas = (*big.Int)(raw.MaxFeePerGas)
+	t.MaxPriorityFeePerGas = (*big.Int)(raw.MaxPriorityFeePerGas)
+	t.Nonce = (*big.Int)(raw.Nonce)
+	t.TransactionIndex = (*big.Int)(raw.TransactionIndex)
+	t.Type = (*big.Int)(raw.Type)
+	t.Value = (*big.Int)(raw.Value)
+	return nil
+}
+
+// UnmarshalJSON will decode the receipt (hex quantities)
+func (r *EthReceipt) UnmarshalJSON(data []byte) error {
+	type alias EthReceipt
+	raw := &struct {
+		*alias
+		BlockNumber       *hexBig `json:"blockNumber"`
+		CumulativeGasUsed *hexBig `json:"cumulativeGasUsed"`
+		EffectiveGasPrice *hexBig `json:"effectiveGasPrice"`
+		GasUsed           *hexBig `json:"gasUsed"`
+		Status            *hexBig `json:"status"`
+		TransactionIndex  *hexBig `json:"transactionIndex"`
+		Type              *hexBig `json:"type"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, raw); err != nil {
+		return err
+	}
+	r.BlockNumber = (*big.Int)(raw.BlockNumber)
+	r.CumulativeGasUsed = (*big.Int)(raw.CumulativeGasUsed)
+	r.EffectiveGasPrice = (*big.Int)(raw.EffectiveGasPrice)
+	r.GasUsed = (*big.Int)(raw.GasUsed)
+	r.TransactionIndex = (*big.Int)(raw.TransactionIndex)
+	r.Type = (*big.Int)(raw.Type)
+	r.Status = nil
+	if raw.Status != nil {
+		status := EthereumTxStatus((*big.Int)(raw.Status).Int64())
+		r.Status = &status
+	}
+	return nil
+}
+
+// UnmarshalJSON will decode the log (hex quantities)
+func (l *EthLog) UnmarshalJSON(data []byte) error {
+	type alias EthLog
+	raw := &struct {
+		*alias
+		BlockNumber      *hexBig `json:"blockNumber"`
+		LogIndex         *hexBig `json:"logIndex"`
+		TransactionIndex *hexBig `json:"transactionIndex"`
+	}{alias: (*alias)(l)}
+	if err := json.Unmarshal(data, raw); err != nil {
+		return err
+	}
+	l.BlockNumber = (*big.Int)(raw.BlockNumber)
+	l.LogIndex = (*big.Int)(raw.LogIndex)
+	l.TransactionIndex = (*big.Int)(raw.TransactionIndex)
+	return nil
+}
+
+// UnmarshalJSON will decode the fee history (hex quantities)
+func (f *EthFeeHistory) UnmarshalJSON(data []byte) error {
+	type alias EthFeeHistory
+	raw := &struct {
+		*alias
+		BaseFeePerGas []*hexBig   `json:"baseFeePerGas"`
+		OldestBlock   *hexBig     `json:"oldestBlock"`
+		Reward        [][]*hexBig `json:"reward"`
+	}{alias: (*alias)(f)}
+	if err := json.Unmarshal(data, raw); err != nil {
+		return err
+	}
+	f.BaseFeePerGas = hexBigs(raw.BaseFeePerGas)
+	f.OldestBlock = (*big.Int)(raw.OldestBlock)
+	f.Reward = nil
+	for _, rewards := range raw.Reward {
+		f.Reward = append(f.Reward, hexBigs(rewards))
+	}
+	return nil
+}
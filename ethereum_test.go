@@ -0,0 +1,474 @@
+package nownodes
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testETHContract = "0xdac17f958d2ee523a2206206994597c13d831ec7"
+	testETHTopic    = "0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"
+)
+
+// ethereumResults are the eth_* results (raw JSON) by method
+var ethereumResults = map[string]string{
+	nodeMethodEthBlockNumber:           `"0xd70c85"`,
+	nodeMethodEthCall:                  `"0x0000000000000000000000000000000000000000000000000000000005f5e100"`,
+	nodeMethodEthEstimateGas:           `"0x5208"`,
+	nodeMethodEthFeeHistory:            `{"oldestBlock":"0xd70c84","baseFeePerGas":["0x18e32aecf8","0x1a2b3c4d5e","0x17d7840000"],"gasUsedRatio":[0.5,0.9],"reward":[["0x3b9aca00","0x77359400"],["0x59682f00","0xb2d05e00"]]}`,
+	nodeMethodEthGasPrice:              `"0x18e32aecf8"`,
+	nodeMethodEthGetBalance:            `"0x6613123627d06a"`,
+	nodeMethodEthGetLogs:               `[{"address":"` + testETHContract + `","topics":["` + testETHTopic + `"],"data":"0x00000000000000000000000000000000000000000000000000000000000001f4","blockNumber":"0xd70c85","transactionHash":"` + testETHTxID + `","transactionIndex":"0x2a","blockHash":"0x5c8a2c3b0e16e0c6b8a0b8b0ef8f8ad2f0b8fdbb9a5e2c8d3b6a8e4c1f0d2a31","logIndex":"0x3c","removed":false}]`,
+	nodeMethodEthGetTransactionByHash:  `{"blockHash":"0x5c8a2c3b0e16e0c6b8a0b8b0ef8f8ad2f0b8fdbb9a5e2c8d3b6a8e4c1f0d2a31","blockNumber":"0xd70c85","chainId":"0x1","from":"` + testETHAddress + `","gas":"0x350ad","gasPrice":"0x18e32aecf8","hash":"` + testETHTxID + `","input":"0x10abbfae","maxFeePerGas":"0x2d00000294","maxPriorityFeePerGas":"0x3b9aca00","nonce":"0x45","r":"0x4b5b","s":"0x6b1a","to":"0xa1c13e02a8b3f833d7b47fa57ba6484f656ee067","transactionIndex":"0x2a","type":"0x2","v":"0x1","value":"0x0"}`,
+	nodeMethodEthGetTransactionCount:   `"0x46"`,
+	nodeMethodEthGetTransactionReceipt: `{"blockHash":"0x5c8a2c3b0e16e0c6b8a0b8b0ef8f8ad2f0b8fdbb9a5e2c8d3b6a8e4c1f0d2a31","blockNumber":"0xd70c85","contractAddress":null,"cumulativeGasUsed":"0x2a7c5d","effectiveGasPrice":"0x18e32aecf8","from":"` + testETHAddress + `","gasUsed":"0xa600","logs":[],"logsBloom":"0x00","status":"0x0","to":"0xa1c13e02a8b3f833d7b47fa57ba6484f656ee067","transactionHash":"` + testETHTxID + `","transactionIndex":"0x2a","type":"0x2"}`,
+	nodeMethodEthSendRawTransaction:    `"` + testETHTxID + `"`,
+}
+
+// ethereumResponse will return the eth_* result (raw JSON) for the method and record the params
+type ethereumResponse struct {
+	mu      sync.Mutex
+	params  map[string]json.RawMessage // Last params by method
+	results map[string]string          // Result by method
+}
+
+func (v *ethereumResponse) Do(req *http.Request) (*http.Response, error) {
+	resp := new(http.Response)
+	resp.StatusCode = http.StatusBadRequest
+
+	// No req found
+	if req == nil {
+		return resp, errors.New("missing request")
+	}
+
+	var data struct {
+		ID     string          `json:"id"`
+		Method string          `json:"method"`
+		Params json.RawMessage `json:"params"`
+	}
+	if err := json.NewDecoder(req.Body).Decode(&data); err != nil {
+		return resp, err
+	}
+	v.mu.Lock()
+	if v.params == nil {
+		v.params = make(map[string]json.RawMessage)
+	}
+	v.params[data.Method] = data.Params
+	v.mu.Unlock()
+
+	// Valid response (known method)
+	if result, ok := v.results[data.Method]; ok && strings.Contains(req.Host, ETH.NodeAPIURL()) {
+		resp.StatusCode = http.StatusOK
+		resp.Body = io.NopCloser(bytes.NewBuffer([]byte(`{"jsonrpc":"2.0","result":` + result + `,"id":"` + data.ID + `"}`)))
+		return resp, nil
+	}
+
+	// Unknown method
+	resp.StatusCode = http.StatusOK
+	resp.Body = io.NopCloser(bytes.NewBuffer([]byte(`{"jsonrpc":"2.0","error":{"code":-32601,"message":"the method ` + data.Method + ` does not exist/is not available"},"id":"` + data.ID + `"}`)))
+	return resp, nil
+}
+
+// sentParams will return the params (raw JSON) sent for the method
+func (v *ethereumResponse) sentParams(method string) string {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	return string(v.params[method])
+}
+
+// newEthereumClient will return the Ethereum client (and the mock) with the given results
+func newEthereumClient(results map[string]string) (EthereumService, *ethereumResponse) {
+	mock := &ethereumResponse{results: results}
+	return NewClient(WithAPIKey(testKey), WithHTTPClient(mock)).Ethereum(), mock
+}
+
+func TestEthBlockTag(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "0x0", EthBlockTag(0))
+	assert.Equal(t, "0xd70c85", EthBlockTag(14093445))
+}
+
+func TestParseHexQuantity(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		quantity string
+		expected string
+		err      error
+	}{
+		{"0x0", "0", nil},
+		{"0x1a", "26", nil},
+		{"0xDE0B6B3A7640000", "1000000000000000000", nil},
+		{"0xffffffffffffffffffffffffffffffff", "340282366920938463463374607431768211455", nil},
+		{"", "", ErrInvalidHexQuantity},
+		{"0x", "", ErrInvalidHexQuantity},
+		{"1a", "", ErrInvalidHexQuantity},
+		{"0xzz", "", ErrInvalidHexQuantity},
+	}
+	for _, testCase := range tests {
+		t.Run("quantity "+testCase.quantity, func(t *testing.T) {
+			value, err := parseHexQuantity(testCase.quantity)
+			if testCase.err != nil {
+				assert.ErrorIs(t, err, testCase.err)
+				assert.Nil(t, value)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expected, value.String())
+		})
+	}
+}
+
+func TestEthereumClient_quantities(t *testing.T) {
+	t.Parallel()
+
+	e, mock := newEthereumClient(ethereumResults)
+	ctx := context.Background()
+
+	t.Run("block number", func(t *testing.T) {
+		value, err := e.BlockNumber(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(14093445), value)
+	})
+
+	t.Run("gas price", func(t *testing.T) {
+		value, err := e.GasPrice(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(106890456312), value)
+	})
+
+	t.Run("balance", func(t *testing.T) {
+		value, err := e.GetBalance(ctx, testETHAddress, "")
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(28731416563404906), value)
+		assert.JSONEq(t, `["`+testETHAddress+`","latest"]`, mock.sentParams(nodeMethodEthGetBalance))
+
+		_, err = e.GetBalance(ctx, "invalid", EthBlockLatest)
+		assert.ErrorIs(t, err, ErrInvalidAddress)
+	})
+
+	t.Run("transaction count", func(t *testing.T) {
+		value, err := e.GetTransactionCount(ctx, testETHAddress, EthBlockPending)
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(70), value)
+		assert.JSONEq(t, `["`+testETHAddress+`","pending"]`, mock.sentParams(nodeMethodEthGetTransactionCount))
+
+		_, err = e.GetTransactionCount(ctx, "", EthBlockLatest)
+		assert.ErrorIs(t, err, ErrInvalidAddress)
+	})
+
+	t.Run("invalid quantity", func(t *testing.T) {
+		bad, _ := newEthereumClient(map[string]string{nodeMethodEthBlockNumber: `"d70c85"`})
+		value, err := bad.BlockNumber(ctx)
+		require.Nil(t, value)
+		assert.ErrorIs(t, err, ErrInvalidHexQuantity)
+	})
+}
+
+func TestEthereumClient_GetTransactionByHash(t *testing.T) {
+	t.Parallel()
+
+	t.Run("valid tx", func(t *testing.T) {
+		e, _ := newEthereumClient(ethereumResults)
+		tx, err := e.GetTransactionByHash(context.Background(), testETHTxID)
+		require.NoError(t, err)
+		require.NotNil(t, tx)
+		assert.Equal(t, testETHTxID, tx.Hash)
+		assert.Equal(t, testETHAddress, tx.From)
+		assert.Equal(t, big.NewInt(14093445), tx.BlockNumber)
+		assert.Equal(t, big.NewInt(1), tx.ChainID)
+		assert.Equal(t, big.NewInt(217261), tx.Gas)
+		assert.Equal(t, big.NewInt(193273528980), tx.MaxFeePerGas)
+		assert.Equal(t, big.NewInt(1000000000), tx.MaxPriorityFeePerGas)
+		assert.Equal(t, big.NewInt(69), tx.Nonce)
+		assert.Equal(t, big.NewInt(2), tx.Type)
+		assert.Equal(t, big.NewInt(0), tx.Value)
+		assert.Equal(t, "0x10abbfae", tx.Input)
+	})
+
+	t.Run("pending tx", func(t *testing.T) {
+		e, _ := newEthereumClient(map[string]string{
+			nodeMethodEthGetTransactionByHash: `{"blockHash":null,"blockNumber":null,"hash":"` + testETHTxID + `","nonce":"0x45","value":"0xde0b6b3a7640000"}`,
+		})
+		tx, err := e.GetTransactionByHash(context.Background(), testETHTxID)
+		require.NoError(t, err)
+		assert.Nil(t, tx.BlockNumber)
+		assert.Equal(t, "1000000000000000000", tx.Value.String())
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		e, _ := newEthereumClient(map[string]string{nodeMethodEthGetTransactionByHash: `null`})
+		tx, err := e.GetTransactionByHash(context.Background(), testETHTxID)
+		require.Nil(t, tx)
+		assert.ErrorIs(t, err, ErrTxNotFound)
+	})
+
+	t.Run("invalid hash", func(t *testing.T) {
+		e, _ := newEthereumClient(ethereumResults)
+		tx, err := e.GetTransactionByHash(context.Background(), testBitcoinTxID)
+		require.Nil(t, tx)
+		assert.ErrorIs(t, err, ErrInvalidTxID)
+	})
+
+	t.Run("invalid quantity", func(t *testing.T) {
+		e, _ := newEthereumClient(map[string]string{
+			nodeMethodEthGetTransactionByHash: `{"hash":"` + testETHTxID + `","nonce":"invalid"}`,
+		})
+		tx, err := e.GetTransactionByHash(context.Background(), testETHTxID)
+		require.Nil(t, tx)
+		assert.ErrorIs(t, err, ErrInvalidHexQuantity)
+	})
+}
+
+func TestEthereumClient_GetTransactionReceipt(t *testing.T) {
+	t.Parallel()
+
+	t.Run("failed tx", func(t *testing.T) {
+		e, _ := newEthereumClient(ethereumResults)
+		receipt, err := e.GetTransactionReceipt(context.Background(), testETHTxID)
+		require.NoError(t, err)
+		require.NotNil(t, receipt)
+		require.NotNil(t, receipt.Status)
+		assert.Equal(t, EthereumTxFailure, *receipt.Status)
+		assert.Equal(t, big.NewInt(42496), receipt.GasUsed)
+		assert.Equal(t, big.NewInt(2784349), receipt.CumulativeGasUsed)
+		assert.Equal(t, big.NewInt(106890456312), receipt.EffectiveGasPrice)
+		assert.Empty(t, receipt.ContractAddress)
+		assert.Empty(t, receipt.Logs)
+	})
+
+	t.Run("successful tx with logs", func(t *testing.T) {
+		e, _ := newEthereumClient(map[string]string{
+			nodeMethodEthGetTransactionReceipt: `{"status":"0x1","gasUsed":"0xa600","logs":` + ethereumResults[nodeMethodEthGetLogs] + `,"transactionHash":"` + testETHTxID + `"}`,
+		})
+		receipt, err := e.GetTransactionReceipt(context.Background(), testETHTxID)
+		require.NoError(t, err)
+		require.NotNil(t, receipt.Status)
+		assert.Equal(t, EthereumTxSuccess, *receipt.Status)
+		require.Len(t, receipt.Logs, 1)
+		assert.Equal(t, big.NewInt(60), receipt.Logs[0].LogIndex)
+	})
+
+	t.Run("pre-byzantium receipt", func(t *testing.T) {
+		e, _ := newEthereumClient(map[string]string{
+			nodeMethodEthGetTransactionReceipt: `{"root":"0x96a8e009d2b88b1483e6941e6812e32263b05683fac202abc622a3e31aed1957","gasUsed":"0x5208","logs":[],"transactionHash":"` + testETHTxID + `"}`,
+		})
+		receipt, err := e.GetTransactionReceipt(context.Background(), testETHTxID)
+		require.NoError(t, err)
+		require.NotNil(t, receipt)
+		assert.Nil(t, receipt.Status)
+		assert.Equal(t, "0x96a8e009d2b88b1483e6941e6812e32263b05683fac202abc622a3e31aed1957", receipt.Root)
+	})
+
+	t.Run("not mined", func(t *testing.T) {
+		e, _ := newEthereumClient(map[string]string{nodeMethodEthGetTransactionReceipt: `null`})
+		receipt, err := e.GetTransactionReceipt(context.Background(), testETHTxID)
+		require.Nil(t, receipt)
+		assert.ErrorIs(t, err, ErrTxNotFound)
+	})
+}
+
+func TestEthereumClient_Call(t *testing.T) {
+	t.Parallel()
+
+	t.Run("valid call", func(t *testing.T) {
+		e, mock := newEthereumClient(ethereumResults)
+		result, err := e.Call(context.Background(), &EthCallMsg{
+			Data: "0x70a08231000000000000000000000000" + strings.TrimPrefix(testETHAddress, "0x"),
+			To:   testETHContract,
+		}, EthBlockTag(14093445))
+		require.NoError(t, err)
+		assert.Equal(t, strings.Trim(ethereumResults[nodeMethodEthCall], `"`), result)
+		assert.JSONEq(t,
+			`[{"data":"0x70a08231000000000000000000000000`+strings.TrimPrefix(testETHAddress, "0x")+`","to":"`+testETHContract+`"},"0xd70c85"]`,
+			mock.sentParams(nodeMethodEthCall),
+		)
+	})
+
+	t.Run("invalid message", func(t *testing.T) {
+		e, _ := newEthereumClient(ethereumResults)
+		_, err := e.Call(context.Background(), nil, "")
+		assert.ErrorIs(t, err, ErrInvalidAddress)
+		_, err = e.Call(context.Background(), &EthCallMsg{Data: "0x"}, "")
+		assert.ErrorIs(t, err, ErrInvalidAddress)
+	})
+
+	t.Run("node error", func(t *testing.T) {
+		e, _ := newEthereumClient(map[string]string{})
+		_, err := e.Call(context.Background(), &EthCallMsg{To: testETHContract}, "")
+		require.Error(t, err)
+
+		var rpcErr *RPCError
+		require.ErrorAs(t, err, &rpcErr)
+		assert.Equal(t, int64(-32601), rpcErr.Code)
+	})
+}
+
+func TestEthereumClient_EstimateGas(t *testing.T) {
+	t.Parallel()
+
+	t.Run("hex quantity params", func(t *testing.T) {
+		e, mock := newEthereumClient(ethereumResults)
+		gas, err := e.EstimateGas(context.Background(), &EthCallMsg{
+			From:     testETHAddress,
+			GasPrice: big.NewInt(106890456312),
+			To:       testETHContract,
+			Value:    new(big.Int).SetUint64(1000000000000000000),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(21000), gas)
+		assert.JSONEq(t,
+			`[{"from":"`+testETHAddress+`","gasPrice":"0x18e32aecf8","to":"`+testETHContract+`","value":"0xde0b6b3a7640000"}]`,
+			mock.sentParams(nodeMethodEthEstimateGas),
+		)
+	})
+
+	t.Run("contract creation", func(t *testing.T) {
+		e, _ := newEthereumClient(ethereumResults)
+		_, err := e.EstimateGas(context.Background(), &EthCallMsg{Data: "0x6080", From: testETHAddress})
+		require.NoError(t, err)
+	})
+
+	t.Run("invalid message", func(t *testing.T) {
+		e, _ := newEthereumClient(ethereumResults)
+		_, err := e.EstimateGas(context.Background(), &EthCallMsg{To: "invalid"})
+		assert.ErrorIs(t, err, ErrInvalidAddress)
+	})
+}
+
+func TestEthereumClient_FeeHistory(t *testing.T) {
+	t.Parallel()
+
+	t.Run("with rewards", func(t *testing.T) {
+		e, mock := newEthereumClient(ethereumResults)
+		history, err := e.FeeHistory(context.Background(), 2, "", []float64{25, 75})
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(14093444), history.OldestBlock)
+		require.Len(t, history.BaseFeePerGas, 3)
+		assert.Equal(t, big.NewInt(106890456312), history.BaseFeePerGas[0])
+		assert.Equal(t, []float64{0.5, 0.9}, history.GasUsedRatio)
+		require.Len(t, history.Reward, 2)
+		assert.Equal(t, []*big.Int{big.NewInt(1500000000), big.NewInt(3000000000)}, history.Reward[1])
+		assert.JSONEq(t, `["0x2","latest",[25,75]]`, mock.sentParams(nodeMethodEthFeeHistory))
+	})
+
+	t.Run("without rewards", func(t *testing.T) {
+		e, mock := newEthereumClient(map[string]string{
+			nodeMethodEthFeeHistory: `{"oldestBlock":"0xd70c84","baseFeePerGas":["0x18e32aecf8","0x1a2b3c4d5e"],"gasUsedRatio":[0.5]}`,
+		})
+		history, err := e.FeeHistory(context.Background(), 1, EthBlockPending, nil)
+		require.NoError(t, err)
+		assert.Nil(t, history.Reward)
+		assert.JSONEq(t, `["0x1","pending",[]]`, mock.sentParams(nodeMethodEthFeeHistory))
+	})
+
+	t.Run("invalid block count", func(t *testing.T) {
+		e, _ := newEthereumClient(ethereumResults)
+		history, err := e.FeeHistory(context.Background(), 0, "", nil)
+		require.Nil(t, history)
+		assert.ErrorIs(t, err, ErrInvalidBlock)
+	})
+}
+
+func TestEthereumClient_GetLogs(t *testing.T) {
+	t.Parallel()
+
+	t.Run("valid filter", func(t *testing.T) {
+		e, mock := newEthereumClient(ethereumResults)
+		logs, err := e.GetLogs(context.Background(), &EthLogFilter{
+			Addresses: []string{testETHContract},
+			FromBlock: EthBlockTag(14093445),
+			ToBlock:   EthBlockLatest,
+			Topics:    [][]string{{testETHTopic}, nil},
+		})
+		require.NoError(t, err)
+		require.Len(t, logs, 1)
+		assert.Equal(t, testETHContract, logs[0].Address)
+		assert.Equal(t, big.NewInt(14093445), logs[0].BlockNumber)
+		assert.Equal(t, big.NewInt(42), logs[0].TransactionIndex)
+		assert.Equal(t, []string{testETHTopic}, logs[0].Topics)
+		assert.JSONEq(t,
+			`[{"address":["`+testETHContract+`"],"fromBlock":"0xd70c85","toBlock":"latest","topics":[["`+testETHTopic+`"],null]}]`,
+			mock.sentParams(nodeMethodEthGetLogs),
+		)
+	})
+
+	t.Run("no logs", func(t *testing.T) {
+		e, mock := newEthereumClient(map[string]string{nodeMethodEthGetLogs: `[]`})
+		logs, err := e.GetLogs(context.Background(), nil)
+		require.NoError(t, err)
+		assert.NotNil(t, logs)
+		assert.Empty(t, logs)
+		assert.JSONEq(t, `[{}]`, mock.sentParams(nodeMethodEthGetLogs))
+	})
+
+	t.Run("invalid address", func(t *testing.T) {
+		e, _ := newEthereumClient(ethereumResults)
+		logs, err := e.GetLogs(context.Background(), &EthLogFilter{Addresses: []string{"invalid"}})
+		require.Nil(t, logs)
+		assert.ErrorIs(t, err, ErrInvalidAddress)
+	})
+}
+
+func TestEthereumClient_SendRawTransaction(t *testing.T) {
+	t.Parallel()
+
+	t.Run("valid broadcast", func(t *testing.T) {
+		e, mock := newEthereumClient(ethereumResults)
+		txHex := strings.TrimSpace(testETHTxHex)
+		hash, err := e.SendRawTransaction(context.Background(), txHex)
+		require.NoError(t, err)
+		assert.Equal(t, testETHTxID, hash)
+		assert.JSONEq(t, `["`+txHex+`"]`, mock.sentParams(nodeMethodEthSendRawTransaction))
+	})
+
+	t.Run("invalid tx hex", func(t *testing.T) {
+		e, _ := newEthereumClient(ethereumResults)
+		for _, txHex := range []string{"", "0x", "02f8b1", "0xzz"} {
+			_, err := e.SendRawTransaction(context.Background(), txHex)
+			assert.ErrorIs(t, err, ErrInvalidTxHex, txHex)
+		}
+	})
+
+	t.Run("error cases", func(t *testing.T) {
+		for _, client := range []HTTPInterface{
+			&errorDoReqErr{}, &errorBadJSONResponse{}, &errorMissingAPIKey{},
+		} {
+			e := NewClient(WithAPIKey(testKey), WithHTTPClient(client)).Ethereum()
+			_, err := e.SendRawTransaction(context.Background(), strings.TrimSpace(testETHTxHex))
+			assert.Error(t, err)
+		}
+	})
+}
+
+func ExampleClient_Ethereum() {
+	e, _ := newEthereumClient(ethereumResults)
+	balance, err := e.GetBalance(context.Background(), testETHAddress, EthBlockLatest)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Printf("balance (wei): %s", balance)
+	// Output:balance (wei): 28731416563404906
+}
+
+func BenchmarkEthereumClient_GetTransactionByHash(b *testing.B) {
+	e, _ := newEthereumClient(ethereumResults)
+	ctx := context.Background()
+	for i := 0; i < b.N; i++ {
+		_, _ = e.GetTransactionByHash(ctx, testETHTxID)
+	}
+}
@@ -0,0 +1,21 @@
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+
+	"github.com/mrz1836/go-nownodes"
+)
+
+func main() {
+	c := nownodes.NewClient(nownodes.WithAPIKey(os.Getenv("NOW_NODES_API_KEY")))
+	info, err := c.GetBlock(
+		context.Background(), nownodes.BSV, "723772", 0,
+	)
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+	log.Println("found block: ", info.BlockHash, "with txs", info.TxCount)
+}
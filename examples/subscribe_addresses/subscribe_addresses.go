@@ -0,0 +1,36 @@
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+	"os/signal"
+
+	"github.com/mrz1836/go-nownodes"
+)
+
+func main() {
+	c := nownodes.NewClient(nownodes.WithAPIKey(os.Getenv("NOW_NODES_API_KEY")))
+	stream := c.NewStreamClient(nownodes.BSV, nil)
+	defer func() {
+		_ = stream.Close()
+	}()
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer cancel()
+
+	sub, err := stream.SubscribeAddresses(ctx, []string{"1GenocdBC1NSHLMbk61fqJXqTdXjevCxCL"})
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+
+	// More addresses can be added (or removed) at any time
+	if err = sub.Add(ctx, "16ZqP5Tb22KJuvSAbjNkoiZs13mmRmexZA"); err != nil {
+		log.Fatal(err)
+		return
+	}
+	for event := range sub.Events() {
+		log.Println("address: ", event.Address, "tx", event.Tx.TxID)
+	}
+}
@@ -0,0 +1,30 @@
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+	"os/signal"
+
+	"github.com/mrz1836/go-nownodes"
+)
+
+func main() {
+	c := nownodes.NewClient(nownodes.WithAPIKey(os.Getenv("NOW_NODES_API_KEY")))
+	stream := c.NewStreamClient(nownodes.BTC, nil)
+	defer func() {
+		_ = stream.Close()
+	}()
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer cancel()
+
+	events, err := stream.SubscribeNewBlock(ctx)
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+	for event := range events {
+		log.Println("new block: ", event.Hash, "at height", event.Height)
+	}
+}
@@ -0,0 +1,142 @@
+package nownodes
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+)
+
+// FeeSource is the API that produced the fee estimate
+type FeeSource string
+
+// Fee estimate sources
+const (
+	FeeSourceBlockBook FeeSource = "blockbook" // BlockBook: /api/v2/estimatefee/
+	FeeSourceNode      FeeSource = "node"      // Node API: estimatesmartfee
+)
+
+// FeeEstimate is the fee estimate returned to the EstimateFee request
+type FeeEstimate struct {
+	Blocks          uint64    `json:"blocks"`            // Confirmation target (in blocks) of the estimate
+	SatoshisPerByte float64   `json:"satoshis_per_byte"` // Fee rate in satoshis per (virtual) byte
+	Source          FeeSource `json:"source"`            // API used for the estimate
+}
+
+// blockBookFeeResult is the BlockBook fee estimate (coins per kilobyte)
+type blockBookFeeResult struct {
+	Result string `json:"result"`
+}
+
+// smartFeeResult is the Node API fee estimate
+type smartFeeResult struct {
+	NodeError
+	ID     string `json:"id,omitempty"`
+	Result *struct {
+		Blocks  uint64   `json:"blocks"`
+		Errors  []string `json:"errors,omitempty"`
+		FeeRate float64  `json:"feerate"` // Coins per kilobyte
+	} `json:"result,omitempty"`
+}
+
+// feeEstimateError is the Node API error of the fee estimate (matches ErrFeeEstimateUnavailable)
+type feeEstimateError struct {
+	err *RPCError
+}
+
+// Error will return the error message (implements the error interface)
+func (e *feeEstimateError) Error() string {
+	return ErrFeeEstimateUnavailable.Error() + ": " + e.err.Error()
+}
+
+// Is will return true if the target is ErrFeeEstimateUnavailable (used by errors.Is)
+func (e *feeEstimateError) Is(target error) bool {
+	return errors.Is(target, ErrFeeEstimateUnavailable)
+}
+
+// Unwrap will return the Node API error (used by errors.As)
+func (e *feeEstimateError) Unwrap() error {
+	return e.err
+}
+
+// EstimateFee will estimate the fee rate needed for a transaction to confirm within the given blocks
+//
+// BlockBook is used first, the Node API (estimatesmartfee) is used if BlockBook has no estimate or is
+// unavailable (5xx), a Node API error is wrapped as ErrFeeEstimateUnavailable (use errors.As for the *RPCError)
+// This method supports the following chains: BCH, BSV, BTC, BTCTestnet, BTG, DASH, DOGE, LTC
+func (c *Client) EstimateFee(ctx context.Context, chain Blockchain, blocks uint64) (*FeeEstimate, error) {
+
+	// Validate the input
+	if blocks == 0 {
+		return nil, ErrInvalidConfirmationTarget
+	}
+
+	// Try BlockBook first (a cancelled context or a client error is returned right away)
+	estimate, err := c.blockBookFeeEstimate(ctx, chain, blocks)
+	if err == nil {
+		return estimate, nil
+	} else if ctx.Err() != nil || !isFeeFallbackError(err) {
+		return nil, err
+	}
+
+	// Fall back to the Node API
+	payload, err := createPayload(c.options.apiKey, nodeMethodEstimateSmartFee, c.nextRequestID(), []interface{}{blocks})
+	if err != nil {
+		return nil, err
+	}
+	result := new(smartFeeResult)
+	if err = nodeRequest(
+		ctx, c, estimateSmartFeeBlockchains, chain, payload, &result,
+	); err != nil {
+		return nil, err
+	} else if result.Error != nil {
+		return nil, &feeEstimateError{err: result.Error}
+	} else if result.Result == nil || result.Result.FeeRate <= 0 {
+		return nil, ErrFeeEstimateUnavailable
+	}
+
+	return &FeeEstimate{
+		Blocks:          result.Result.Blocks,
+		SatoshisPerByte: coinsPerKBToSatoshisPerByte(result.Result.FeeRate),
+		Source:          FeeSourceNode,
+	}, nil
+}
+
+// blockBookFeeEstimate will get the BlockBook fee estimate (using the WebSocket if enabled)
+func (c *Client) blockBookFeeEstimate(ctx context.Context, chain Blockchain, blocks uint64) (*FeeEstimate, error) {
+
+	// Use the WebSocket (if enabled)
+	if stream := c.streamTransport(chain); stream != nil {
+		return stream.EstimateFee(ctx, blocks)
+	}
+
+	// Fire the HTTP request
+	result := new(blockBookFeeResult)
+	if err := blockBookRequest(
+		ctx, c, estimateFeeBlockchains, chain,
+		routeEstimateFee+strconv.FormatUint(blocks, 10), &result,
+	); err != nil {
+		return nil, err
+	}
+	rate, _ := strconv.ParseFloat(result.Result, 64)
+	if rate <= 0 {
+		return nil, ErrFeeEstimateUnavailable
+	}
+	return &FeeEstimate{
+		Blocks:          blocks,
+		SatoshisPerByte: coinsPerKBToSatoshisPerByte(rate),
+		Source:          FeeSourceBlockBook,
+	}, nil
+}
+
+// isFeeFallbackError will return true if the Node API should be used (no BlockBook estimate or BlockBook is unavailable)
+func isFeeFallbackError(err error) bool {
+	var apiErr *APIError
+	return errors.Is(err, ErrFeeEstimateUnavailable) ||
+		(errors.As(err, &apiErr) && apiErr.StatusCode >= http.StatusInternalServerError)
+}
+
+// coinsPerKBToSatoshisPerByte will convert a fee rate in coins/kB into satoshis/byte
+func coinsPerKBToSatoshisPerByte(rate float64) float64 {
+	return rate * satoshisPerCoin / bytesPerKilobyte
+}
@@ -0,0 +1,202 @@
+package nownodes
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// feeResponse will return a fee estimate from BlockBook and/or the Node API
+type feeResponse struct {
+	blockBookFee    string // BlockBook result (empty for a BlockBook error)
+	blockBookStatus int    // BlockBook error status (zero will use 500)
+	nodeIDs         []string
+	nodeResult      string // Node API response body
+}
+
+func (v *feeResponse) Do(req *http.Request) (*http.Response, error) {
+	resp := new(http.Response)
+	resp.StatusCode = http.StatusBadRequest
+
+	// No req found
+	if req == nil {
+		return resp, errors.New("missing request")
+	}
+
+	// BlockBook response
+	if req.Method == http.MethodGet && strings.Contains(req.URL.String(), routeEstimateFee) {
+		if len(v.blockBookFee) == 0 {
+			resp.StatusCode = http.StatusInternalServerError
+			if v.blockBookStatus > 0 {
+				resp.StatusCode = v.blockBookStatus
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer([]byte(`{"error":"Estimating fee failed"}`)))
+			return resp, nil
+		}
+		resp.StatusCode = http.StatusOK
+		resp.Body = io.NopCloser(bytes.NewBuffer([]byte(`{"result":"` + v.blockBookFee + `"}`)))
+		return resp, nil
+	}
+
+	// Node API response
+	if req.Method == http.MethodPost {
+		var data nodePayload
+		if err := json.NewDecoder(req.Body).Decode(&data); err != nil {
+			return resp, err
+		}
+		if data.Method == nodeMethodEstimateSmartFee && len(data.Params) == 1 {
+			v.nodeIDs = append(v.nodeIDs, data.ID)
+			resp.StatusCode = http.StatusOK
+			resp.Body = io.NopCloser(bytes.NewBuffer([]byte(v.nodeResult)))
+			return resp, nil
+		}
+	}
+
+	resp.Body = io.NopCloser(bytes.NewBuffer([]byte(`{"error":"no-route-found"}`)))
+	return resp, errors.New("request not found")
+}
+
+func TestClient_EstimateFee(t *testing.T) {
+	t.Parallel()
+
+	t.Run("blockbook estimate", func(t *testing.T) {
+		c := NewClient(WithAPIKey(testKey), WithHTTPClient(&feeResponse{blockBookFee: "0.00012"}))
+		for _, chain := range estimateFeeBlockchains {
+			t.Run("chain "+chain.String()+": EstimateFee()", func(t *testing.T) {
+				estimate, err := c.EstimateFee(context.Background(), chain, 2)
+				require.NoError(t, err)
+				require.NotNil(t, estimate)
+				assert.Equal(t, uint64(2), estimate.Blocks)
+				assert.InDelta(t, 12.0, estimate.SatoshisPerByte, 0.0001)
+				assert.Equal(t, FeeSourceBlockBook, estimate.Source)
+			})
+		}
+	})
+
+	t.Run("node fallback on blockbook error", func(t *testing.T) {
+		mock := &feeResponse{
+			nodeResult: `{"result":{"feerate":0.00001,"blocks":3},"error":null,"id":"1"}`,
+		}
+		c := NewClient(WithHTTPClient(mock))
+		estimate, err := c.EstimateFee(context.Background(), BTC, 2)
+		require.NoError(t, err)
+		require.NotNil(t, estimate)
+		assert.Equal(t, uint64(3), estimate.Blocks)
+		assert.InDelta(t, 1.0, estimate.SatoshisPerByte, 0.0001)
+		assert.Equal(t, FeeSourceNode, estimate.Source)
+
+		// Every request uses a new id
+		_, err = c.EstimateFee(context.Background(), BTC, 2)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"1", "2"}, mock.nodeIDs)
+	})
+
+	t.Run("no fallback on blockbook client error", func(t *testing.T) {
+		mock := &feeResponse{
+			blockBookStatus: http.StatusTooManyRequests,
+			nodeResult:      `{"result":{"feerate":0.00001,"blocks":3},"error":null,"id":"1"}`,
+		}
+		c := NewClient(WithHTTPClient(mock))
+		estimate, err := c.EstimateFee(context.Background(), BTC, 2)
+		require.Nil(t, estimate)
+		assert.ErrorIs(t, err, ErrRateLimited)
+		assert.Empty(t, mock.nodeIDs)
+	})
+
+	t.Run("no fallback on cancelled context", func(t *testing.T) {
+		mock := &feeResponse{
+			nodeResult: `{"result":{"feerate":0.00001,"blocks":3},"error":null,"id":"1"}`,
+		}
+		c := NewClient(WithHTTPClient(mock))
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		estimate, err := c.EstimateFee(ctx, BTC, 2)
+		require.Error(t, err)
+		require.Nil(t, estimate)
+		assert.Empty(t, mock.nodeIDs)
+	})
+
+	t.Run("node fallback on no blockbook estimate", func(t *testing.T) {
+		c := NewClient(WithHTTPClient(&feeResponse{
+			blockBookFee: "-1",
+			nodeResult:   `{"result":{"feerate":0.00002,"blocks":6},"error":null,"id":"1"}`,
+		}))
+		estimate, err := c.EstimateFee(context.Background(), LTC, 6)
+		require.NoError(t, err)
+		require.NotNil(t, estimate)
+		assert.InDelta(t, 2.0, estimate.SatoshisPerByte, 0.0001)
+		assert.Equal(t, FeeSourceNode, estimate.Source)
+	})
+
+	t.Run("no estimate available", func(t *testing.T) {
+		c := NewClient(WithHTTPClient(&feeResponse{
+			blockBookFee: "-1",
+			nodeResult:   `{"result":{"errors":["Insufficient data or no feerate found"],"blocks":0},"error":null,"id":"1"}`,
+		}))
+		estimate, err := c.EstimateFee(context.Background(), BTC, 2)
+		require.Error(t, err)
+		require.Nil(t, estimate)
+		assert.ErrorIs(t, err, ErrFeeEstimateUnavailable)
+	})
+
+	t.Run("node error", func(t *testing.T) {
+		c := NewClient(WithHTTPClient(&feeResponse{
+			nodeResult: `{"result":null,"error":{"code":-32601,"message":"Method not found"},"id":"1"}`,
+		}))
+		estimate, err := c.EstimateFee(context.Background(), BSV, 2)
+		require.Error(t, err)
+		require.Nil(t, estimate)
+		assert.ErrorIs(t, err, ErrFeeEstimateUnavailable)
+
+		var rpcErr *RPCError
+		require.ErrorAs(t, err, &rpcErr)
+		assert.Equal(t, int64(-32601), rpcErr.Code)
+	})
+
+	t.Run("invalid confirmation target", func(t *testing.T) {
+		c := NewClient(WithHTTPClient(&feeResponse{blockBookFee: "0.00012"}))
+		estimate, err := c.EstimateFee(context.Background(), BTC, 0)
+		require.Error(t, err)
+		require.Nil(t, estimate)
+		assert.ErrorIs(t, err, ErrInvalidConfirmationTarget)
+	})
+
+	t.Run("unsupported chain", func(t *testing.T) {
+		c := NewClient(WithHTTPClient(&feeResponse{blockBookFee: "0.00012"}))
+		estimate, err := c.EstimateFee(context.Background(), ETH, 2)
+		require.Error(t, err)
+		require.Nil(t, estimate)
+		assert.ErrorIs(t, err, ErrUnsupportedBlockchain)
+	})
+
+	t.Run("http req error", func(t *testing.T) {
+		c := NewClient(WithHTTPClient(&errorDoReqErr{}))
+		estimate, err := c.EstimateFee(context.Background(), BTC, 2)
+		require.Error(t, err)
+		require.Nil(t, estimate)
+	})
+}
+
+func ExampleClient_EstimateFee() {
+	c := NewClient(WithHTTPClient(&feeResponse{blockBookFee: "0.00012"}))
+	estimate, _ := c.EstimateFee(context.Background(), BTC, 2)
+	fmt.Printf("fee rate: %.0f sat/byte from %s", estimate.SatoshisPerByte, estimate.Source)
+	// Output:fee rate: 12 sat/byte from blockbook
+}
+
+func BenchmarkClient_EstimateFee(b *testing.B) {
+	c := NewClient(WithHTTPClient(&feeResponse{blockBookFee: "0.00012"}))
+	ctx := context.Background()
+	for i := 0; i < b.N; i++ {
+		_, _ = c.EstimateFee(ctx, BTC, 2)
+	}
+}
@@ -4,6 +4,7 @@ go 1.18
 
 require (
 	github.com/gojektech/heimdall/v6 v6.1.0
+	github.com/gorilla/websocket v1.5.3
 	github.com/stretchr/testify v1.10.0
 )
 
@@ -1,29 +1,124 @@
 package nownodes
 
-import "context"
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"math/big"
+)
 
 // AddressService is the address related requests
 type AddressService interface {
 	GetAddress(ctx context.Context, chain Blockchain, address string) (*AddressInfo, error)
+	GetAddressWithOptions(ctx context.Context, chain Blockchain, address string, options *AddressOptions) (*AddressInfo, error)
+	GetBalanceHistory(ctx context.Context, chain Blockchain, addressOrXPub string, options *BalanceHistoryOptions) ([]*BalanceHistory, error)
+	GetUTXOs(ctx context.Context, chain Blockchain, addressOrXPub string, confirmedOnly bool) ([]*UTXO, error)
+	GetXPub(ctx context.Context, chain Blockchain, xPub string, options *XPubOptions) (*XPubInfo, error)
+}
+
+// BlockService is the block related requests
+type BlockService interface {
+	GetBlock(ctx context.Context, chain Blockchain, hashOrHeight string, page uint64) (*BlockInfo, error)
+	GetBlockHash(ctx context.Context, chain Blockchain, height uint64) (*BlockHashInfo, error)
+	GetBlockHeader(ctx context.Context, chain Blockchain, hash string, verbose bool) (*BlockHeader, error)
+	GetBlockRPC(ctx context.Context, chain Blockchain, hash string, verbosity BlockVerbosity, w io.Writer) (*RawBlock, error)
+}
+
+// ChainService is the chain state related requests (NodeAPI)
+type ChainService interface {
+	GetBestBlockHash(ctx context.Context, chain Blockchain) (string, error)
+	GetBlockchainInfo(ctx context.Context, chain Blockchain) (*BlockchainInfo, error)
+	GetBlockCount(ctx context.Context, chain Blockchain) (uint64, error)
+	GetChainTips(ctx context.Context, chain Blockchain) ([]*ChainTip, error)
+	GetDifficulty(ctx context.Context, chain Blockchain) (float64, error)
+}
+
+// DiagnosticsService is the node diagnostics related requests (NodeAPI)
+type DiagnosticsService interface {
+	GetConnectionCount(ctx context.Context, chain Blockchain) (uint64, error)
+	GetMiningInfo(ctx context.Context, chain Blockchain) (*MiningInfo, error)
+	GetNetworkInfo(ctx context.Context, chain Blockchain) (*NetworkInfo, error)
+	GetPeerInfo(ctx context.Context, chain Blockchain) ([]*PeerInfo, error)
+}
+
+// EthereumService is the Ethereum NodeAPI (JSON-RPC) requests (eth_* methods)
+type EthereumService interface {
+	BlockNumber(ctx context.Context) (*big.Int, error)
+	Call(ctx context.Context, msg *EthCallMsg, block string) (string, error)
+	EstimateGas(ctx context.Context, msg *EthCallMsg) (*big.Int, error)
+	FeeHistory(ctx context.Context, blockCount uint64, newestBlock string, rewardPercentiles []float64) (*EthFeeHistory, error)
+	GasPrice(ctx context.Context) (*big.Int, error)
+	GetBalance(ctx context.Context, address, block string) (*big.Int, error)
+	GetLogs(ctx context.Context, filter *EthLogFilter) ([]*EthLog, error)
+	GetTransactionByHash(ctx context.Context, hash string) (*EthTransaction, error)
+	GetTransactionCount(ctx context.Context, address, block string) (*big.Int, error)
+	GetTransactionReceipt(ctx context.Context, hash string) (*EthReceipt, error)
+	SendRawTransaction(ctx context.Context, txHex string) (string, error)
+}
+
+// FeeService is the fee related requests
+type FeeService interface {
+	EstimateFee(ctx context.Context, chain Blockchain, blocks uint64) (*FeeEstimate, error)
 }
 
 // MempoolService is the mempool related requests
 type MempoolService interface {
+	GetMempoolAncestors(ctx context.Context, chain Blockchain, txID string, verbose bool) (*RawMempool, error)
+	GetMempoolDescendants(ctx context.Context, chain Blockchain, txID string, verbose bool) (*RawMempool, error)
 	GetMempoolEntry(ctx context.Context, chain Blockchain, txID, id string) (*MempoolEntryResult, error)
+	GetMempoolInfo(ctx context.Context, chain Blockchain) (*MempoolInfo, error)
+	GetRawMempool(ctx context.Context, chain Blockchain, verbose bool) (*RawMempool, error)
+	TestMempoolAccept(ctx context.Context, chain Blockchain, txHexes []string, maxFeeRate float64) ([]*MempoolAcceptResult, error)
+}
+
+// NodeService is the generic NodeAPI (JSON-RPC) requests
+type NodeService interface {
+	Call(ctx context.Context, chain Blockchain, method string, params []interface{}, result interface{}) error
+	CallRaw(ctx context.Context, chain Blockchain, method string, params []interface{}) (json.RawMessage, error)
+	NewBatch(chain Blockchain) *Batch
+}
+
+// StatusService is the status related requests
+type StatusService interface {
+	GetStatus(ctx context.Context, chain Blockchain) (*StatusInfo, error)
+}
+
+// StreamService is the Blockbook WebSocket related requests
+type StreamService interface {
+	NewStreamClient(chain Blockchain, options *StreamOptions) *StreamClient
+}
+
+// TickerService is the fiat rate related requests
+type TickerService interface {
+	GetTickers(ctx context.Context, chain Blockchain, timestamp int64, currencies []string) (*TickerInfo, error)
+	GetTickersList(ctx context.Context, chain Blockchain, timestamp int64) (*TickersList, error)
 }
 
 // TransactionService is the transaction related requests
 type TransactionService interface {
+	DecodeRawTransaction(ctx context.Context, chain Blockchain, txHex string) (*RawTransaction, error)
+	GetRawTransaction(ctx context.Context, chain Blockchain, txID string, verbose bool) (*RawTransaction, error)
 	GetTransaction(ctx context.Context, chain Blockchain, txID string) (*TransactionInfo, error)
+	GetTxOut(ctx context.Context, chain Blockchain, txID string, vout uint64, includeMempool bool) (*TxOut, error)
 	SendTransaction(ctx context.Context, chain Blockchain, txHex string) (*BroadcastResult, error)
-	SendRawTransaction(ctx context.Context, chain Blockchain, txHex, id string) (*BroadcastResult, error)
+	SendRawTransaction(ctx context.Context, chain Blockchain, txHex, id string, opts ...SendOps) (*BroadcastResult, error)
 }
 
 // ClientInterface is the client interface
 type ClientInterface interface {
 	AddressService
+	BlockService
+	ChainService
+	DiagnosticsService
+	FeeService
 	MempoolService
+	NodeService
+	StatusService
+	StreamService
+	TickerService
 	TransactionService
+	Close() error
+	Ethereum() EthereumService
 	HTTPClient() HTTPInterface
 	UserAgent() string
 }
@@ -1,6 +1,9 @@
 package nownodes
 
-import "context"
+import (
+	"context"
+	"sort"
+)
 
 // MempoolEntryResult is the mempool entry result
 type MempoolEntryResult struct {
@@ -11,12 +14,55 @@ type MempoolEntryResult struct {
 
 // MempoolEntry is the mempool entry
 type MempoolEntry struct {
-	Depends     []string `json:"depends"`
-	Fee         float64  `json:"fee,omitempty"`
-	Height      uint64   `json:"height"`
-	ModifiedFee float64  `json:"modifiedfee,omitempty"`
-	Size        int64    `json:"size,omitempty"`
-	Time        int64    `json:"time"`
+	AncestorCount     uint64       `json:"ancestorcount,omitempty"`
+	AncestorFees      int64        `json:"ancestorfees,omitempty"` // In satoshis (deprecated in BTC, see Fees)
+	AncestorSize      uint64       `json:"ancestorsize,omitempty"`
+	BIP125Replaceable bool         `json:"bip125-replaceable,omitempty"` // BTC, LTC
+	Depends           []string     `json:"depends"`
+	DescendantCount   uint64       `json:"descendantcount,omitempty"`
+	DescendantFees    int64        `json:"descendantfees,omitempty"` // In satoshis (deprecated in BTC, see Fees)
+	DescendantSize    uint64       `json:"descendantsize,omitempty"`
+	Fee               float64      `json:"fee,omitempty"`
+	Fees              *MempoolFees `json:"fees,omitempty"` // BTC, LTC
+	Height            uint64       `json:"height"`
+	ModifiedFee       float64      `json:"modifiedfee,omitempty"`
+	Size              int64        `json:"size,omitempty"`
+	SpentBy           []string     `json:"spentby,omitempty"`
+	Time              int64        `json:"time"`
+	VSize             uint64       `json:"vsize,omitempty"`  // BTC, LTC
+	WTxID             string       `json:"wtxid,omitempty"`  // BTC, LTC
+	Weight            uint64       `json:"weight,omitempty"` // BTC, LTC
+}
+
+// MempoolFees are the fees (in coins) of a mempool entry and its in-mempool relatives
+type MempoolFees struct {
+	Ancestor   float64 `json:"ancestor"`
+	Base       float64 `json:"base"`
+	Descendant float64 `json:"descendant"`
+	Modified   float64 `json:"modified"`
+}
+
+// MempoolInfo is the mempool state returned to the GetMempoolInfo request
+type MempoolInfo struct {
+	Bytes            uint64  `json:"bytes"`
+	FullRBF          bool    `json:"fullrbf,omitempty"` // BTC (v24+)
+	Loaded           bool    `json:"loaded,omitempty"`
+	MaxMempool       uint64  `json:"maxmempool"`
+	MempoolMinFee    float64 `json:"mempoolminfee"`
+	MinRelayTxFee    float64 `json:"minrelaytxfee"`
+	Size             uint64  `json:"size"`
+	TotalFee         float64 `json:"total_fee,omitempty"`
+	UnbroadcastCount uint64  `json:"unbroadcastcount,omitempty"` // BTC
+	Usage            uint64  `json:"usage"`
+}
+
+// RawMempool is the list of transactions returned to the GetRawMempool,
+// GetMempoolAncestors and GetMempoolDescendants requests
+//
+// TxIDs is always set (sorted), Entries is only set when verbose was requested
+type RawMempool struct {
+	Entries map[string]*MempoolEntry `json:"entries,omitempty"`
+	TxIDs   []string                 `json:"txids"`
 }
 
 // GetMempoolEntry will get the mempool entry information for a given txID
@@ -35,13 +81,105 @@ func (c *Client) GetMempoolEntry(ctx context.Context, chain Blockchain, txID, id
 	}
 
 	// Fire the HTTP request
+	payload, err := createPayload(c.options.apiKey, nodeMethodGetMempoolEntry, id, []interface{}{txID})
+	if err != nil {
+		return nil, err
+	}
 	results := new(MempoolEntryResult)
-	if err := nodeRequest(
-		ctx, c, getMempoolEntryBlockchains, chain,
-		createPayload(c.options.apiKey, nodeMethodGetMempoolEntry, id, []string{txID}),
-		&results,
+	if err = nodeRequest(
+		ctx, c, getMempoolEntryBlockchains, chain, payload, &results,
 	); err != nil {
 		return nil, err
 	}
 	return results, nil
 }
+
+// GetMempoolInfo will get the current state of the node's mempool
+//
+// This method supports the following chains: BCH, BSV, BTC, BTCTestnet, BTG, DASH, DOGE, LTC
+func (c *Client) GetMempoolInfo(ctx context.Context, chain Blockchain) (*MempoolInfo, error) {
+	info := new(MempoolInfo)
+	if err := c.call(
+		ctx, getMempoolInfoBlockchains, chain, nodeMethodGetMempoolInfo, nil, info,
+	); err != nil {
+		return nil, err
+	}
+	return info, nil
+}
+
+// GetRawMempool will get all the transaction ids in the node's mempool
+//
+// verbose: if true, each mempool entry is also returned (can be a very large response)
+//
+// This method supports the following chains: BCH, BSV, BTC, BTCTestnet, BTG, DASH, DOGE, LTC
+func (c *Client) GetRawMempool(ctx context.Context, chain Blockchain, verbose bool) (*RawMempool, error) {
+	return c.mempoolList(
+		ctx, getRawMempoolBlockchains, chain, nodeMethodGetRawMempool, []interface{}{verbose}, verbose,
+	)
+}
+
+// GetMempoolAncestors will get all the in-mempool ancestors of a given txID
+//
+// verbose: if true, each ancestor's mempool entry is also returned
+//
+// This method supports the following chains: BCH, BSV, BTC, BTCTestnet, BTG, DASH, DOGE, LTC
+func (c *Client) GetMempoolAncestors(ctx context.Context, chain Blockchain, txID string,
+	verbose bool) (*RawMempool, error) {
+
+	// Validate the input
+	if !chain.ValidateTxID(txID) {
+		return nil, ErrInvalidTxID
+	}
+
+	return c.mempoolList(
+		ctx, getMempoolAncestorsBlockchains, chain, nodeMethodGetMempoolAncestors, []interface{}{txID, verbose}, verbose,
+	)
+}
+
+// GetMempoolDescendants will get all the in-mempool descendants of a given txID
+//
+// verbose: if true, each descendant's mempool entry is also returned
+//
+// This method supports the following chains: BCH, BSV, BTC, BTCTestnet, BTG, DASH, DOGE, LTC
+func (c *Client) GetMempoolDescendants(ctx context.Context, chain Blockchain, txID string,
+	verbose bool) (*RawMempool, error) {
+
+	// Validate the input
+	if !chain.ValidateTxID(txID) {
+		return nil, ErrInvalidTxID
+	}
+
+	return c.mempoolList(
+		ctx, getMempoolDescendantsBlockchains, chain, nodeMethodGetMempoolDescendants, []interface{}{txID, verbose}, verbose,
+	)
+}
+
+// mempoolList will fire a mempool listing method which returns either
+// a list of tx ids or a map of tx id => mempool entry (verbose)
+func (c *Client) mempoolList(ctx context.Context, chains []Blockchain, chain Blockchain,
+	method string, params []interface{}, verbose bool) (*RawMempool, error) {
+
+	mempool := new(RawMempool)
+
+	// Simple list of tx ids
+	if !verbose {
+		if err := c.call(ctx, chains, chain, method, params, &mempool.TxIDs); err != nil {
+			return nil, err
+		}
+		if mempool.TxIDs == nil {
+			mempool.TxIDs = []string{}
+		}
+		return mempool, nil
+	}
+
+	// Map of tx id => mempool entry
+	if err := c.call(ctx, chains, chain, method, params, &mempool.Entries); err != nil {
+		return nil, err
+	}
+	mempool.TxIDs = make([]string, 0, len(mempool.Entries))
+	for txID := range mempool.Entries {
+		mempool.TxIDs = append(mempool.TxIDs, txID)
+	}
+	sort.Strings(mempool.TxIDs)
+	return mempool, nil
+}
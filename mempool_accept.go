@@ -0,0 +1,84 @@
+package nownodes
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// MempoolAcceptResult is the result (per transaction) returned to the TestMempoolAccept request
+type MempoolAcceptResult struct {
+	Allowed      bool               `json:"allowed"`
+	Fees         *MempoolAcceptFees `json:"fees,omitempty"`          // BTC (only if allowed)
+	PackageError string             `json:"package-error,omitempty"` // BTC (v24+)
+	RejectReason string             `json:"reject-reason,omitempty"` // IE: min relay fee not met
+	TxID         string             `json:"txid"`
+	VSize        uint64             `json:"vsize,omitempty"` // BTC, LTC (only if allowed)
+	WTxID        string             `json:"wtxid,omitempty"` // BTC, LTC
+}
+
+// MempoolAcceptFees are the fees (in coins) of an accepted transaction
+type MempoolAcceptFees struct {
+	Base float64 `json:"base"`
+}
+
+// RejectError is when the transaction would be rejected by the node's mempool
+//
+// Use errors.Is() with the ErrRPC* sentinels to match the reason (the same as the *RPCError of a broadcast)
+type RejectError struct {
+	Reason string // IE: min relay fee not met, bad-txns-inputs-missingorspent
+	TxID   string
+}
+
+// Error will return the error message (implements the error interface)
+func (e *RejectError) Error() string {
+	return fmt.Sprintf("tx [%s] rejected [%s]", e.TxID, e.Reason)
+}
+
+// Is will return true if the target is a sentinel matching the reject reason (used by errors.Is)
+//
+// Matches the same sentinels as the NodeAPI error of broadcasting the transaction (see rpcError)
+func (e *RejectError) Is(target error) bool {
+	return e.rpcError().Is(target)
+}
+
+// rpcError will return the NodeAPI error that broadcasting the transaction would return for the reject reason
+func (e *RejectError) rpcError() *RPCError {
+	code := int64(rpcCodeVerifyRejected)
+	if strings.Contains(e.Reason, "missing-inputs") || strings.Contains(e.Reason, "inputs-missingorspent") {
+		code = rpcCodeMissingInputs
+	}
+	return &RPCError{Code: code, Message: e.Reason}
+}
+
+// TestMempoolAccept will check if the raw transactions would be accepted by the mempool (nothing is broadcast)
+//
+// param: maxFeeRate is the max fee rate in coins/kvB (zero will use the node default)
+// This method supports the following chains: BCH, BTC, BTCTestnet, BTG, DASH, LTC
+func (c *Client) TestMempoolAccept(ctx context.Context, chain Blockchain, txHexes []string,
+	maxFeeRate float64) ([]*MempoolAcceptResult, error) {
+
+	// Validate the input
+	if len(txHexes) == 0 {
+		return nil, ErrInvalidTxHex
+	}
+	for _, txHex := range txHexes {
+		if !chain.ValidateTxHex(txHex) {
+			return nil, ErrInvalidTxHex
+		}
+	}
+
+	// Only send the max fee rate if it was set
+	params := []interface{}{txHexes}
+	if maxFeeRate > 0 {
+		params = append(params, maxFeeRate)
+	}
+
+	var results []*MempoolAcceptResult
+	if err := c.call(
+		ctx, testMempoolAcceptBlockchains, chain, nodeMethodTestMempoolAccept, params, &results,
+	); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
@@ -0,0 +1,194 @@
+package nownodes
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mempoolAcceptResponse will accept (or reject with the reason) all txs and count the broadcasts
+type mempoolAcceptResponse struct {
+	broadcasts   int    // Number of sendrawtransaction requests
+	noResults    bool   // Return an empty result list
+	rejectReason string // Empty will accept all txs
+}
+
+func (v *mempoolAcceptResponse) Do(req *http.Request) (*http.Response, error) {
+	resp := new(http.Response)
+	resp.StatusCode = http.StatusBadRequest
+
+	// No req found
+	if req == nil {
+		return resp, errors.New("missing request")
+	}
+
+	var data nodePayload
+	if err := json.NewDecoder(req.Body).Decode(&data); err != nil {
+		return resp, err
+	}
+
+	for _, chain := range allBlockchains {
+		if !strings.Contains(req.Host, chain.NodeAPIURL()) {
+			continue
+		}
+		resp.StatusCode = http.StatusOK
+		switch data.Method {
+		case nodeMethodTestMempoolAccept:
+			result := `[{"txid":"` + testTxHexID(chain) + `","wtxid":"` + testTxHexID(chain) + `","allowed":true,"vsize":191,"fees":{"base":0.00000191}}]`
+			if len(v.rejectReason) > 0 {
+				result = `[{"txid":"` + testTxHexID(chain) + `","wtxid":"` + testTxHexID(chain) + `","allowed":false,"reject-reason":"` + v.rejectReason + `"}]`
+			}
+			if v.noResults {
+				result = `[]`
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer([]byte(`{"result": ` + result + `,"error": null,"id": "` + data.ID + `"}`)))
+		case nodeMethodSendRawTx:
+			v.broadcasts++
+			resp.Body = io.NopCloser(bytes.NewBuffer([]byte(`{"result": "` + testTxHexID(chain) + `","error": null,"id": "` + data.ID + `"}`)))
+		default:
+			continue
+		}
+		return resp, nil
+	}
+
+	resp.Body = io.NopCloser(bytes.NewBuffer([]byte(`{"error":"no-route-found"}`)))
+	return resp, errors.New("request not found")
+}
+
+func TestRejectError_Error(t *testing.T) {
+	err := &RejectError{Reason: "min relay fee not met", TxID: testTxHexID(BTC)}
+	assert.Equal(t, "tx ["+testTxHexID(BTC)+"] rejected [min relay fee not met]", err.Error())
+}
+
+func TestRejectError_Is(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		reason      string
+		sentinel    error
+		shouldMatch bool
+	}{
+		{"min relay fee not met", ErrRPCVerifyRejected, true},
+		{"min relay fee not met", ErrRPCMissingInputs, false},
+		{"mempool min fee not met", ErrRPCVerifyRejected, true},
+		{"max-fee-exceeded", ErrRPCVerifyRejected, true},
+		{"bad-txns-inputs-missingorspent", ErrRPCVerifyRejected, false},
+		{"bad-txns-inputs-missingorspent", ErrRPCMissingInputs, true},
+		{"bad-txns-in-belowout", ErrRPCVerifyRejected, true},
+		{"mandatory-script-verify-flag-failed (Signature must be zero for failed CHECK(MULTI)SIG operation)", ErrRPCVerifyRejected, true},
+		{"non-final", ErrRPCVerifyRejected, true},
+		{"dust", ErrRPCVerifyRejected, true},
+		{"missing-inputs", ErrRPCMissingInputs, true},
+		{"missing-inputs", ErrRPCVerifyRejected, false},
+		{"txn-already-in-mempool", ErrRPCTxAlreadyKnown, true},
+		{"txn-already-known", ErrRPCTxAlreadyKnown, true},
+		{"min relay fee not met", ErrRPCTxAlreadyKnown, false},
+		{"txn-mempool-conflict", ErrRPCNotFound, false},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.reason+": "+testCase.sentinel.Error(), func(t *testing.T) {
+			var err error = &RejectError{Reason: testCase.reason, TxID: testTxHexID(BTC)}
+			assert.Equal(t, testCase.shouldMatch, errors.Is(err, testCase.sentinel))
+
+			// Same as the NodeAPI error of the broadcast
+			var rpcErr error = &RPCError{Code: rpcCodeVerifyRejected, Message: "66: " + testCase.reason}
+			if testCase.reason == "missing-inputs" || testCase.reason == "bad-txns-inputs-missingorspent" {
+				rpcErr = &RPCError{Code: rpcCodeMissingInputs, Message: testCase.reason}
+			}
+			assert.Equal(t, errors.Is(rpcErr, testCase.sentinel), errors.Is(err, testCase.sentinel))
+		})
+	}
+}
+
+func TestClient_TestMempoolAccept(t *testing.T) {
+	t.Parallel()
+
+	t.Run("valid cases", func(t *testing.T) {
+		c := NewClient(WithAPIKey(testKey), WithHTTPClient(&mempoolAcceptResponse{}))
+		for _, chain := range testMempoolAcceptBlockchains {
+			t.Run("chain "+chain.String()+": TestMempoolAccept()", func(t *testing.T) {
+				results, err := c.TestMempoolAccept(context.Background(), chain, []string{testTxHex(chain)}, 0.1)
+				require.NoError(t, err)
+				require.Len(t, results, 1)
+				assert.True(t, results[0].Allowed)
+				assert.Equal(t, testTxHexID(chain), results[0].TxID)
+				assert.Equal(t, uint64(191), results[0].VSize)
+				require.NotNil(t, results[0].Fees)
+				assert.InDelta(t, 0.00000191, results[0].Fees.Base, 0.000000001)
+				assert.Empty(t, results[0].RejectReason)
+			})
+		}
+	})
+
+	t.Run("rejected", func(t *testing.T) {
+		c := NewClient(WithHTTPClient(&mempoolAcceptResponse{rejectReason: "min relay fee not met"}))
+		results, err := c.TestMempoolAccept(context.Background(), BTC, []string{testTxHex(BTC)}, 0)
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		assert.False(t, results[0].Allowed)
+		assert.Equal(t, "min relay fee not met", results[0].RejectReason)
+	})
+
+	t.Run("missing or invalid tx hex", func(t *testing.T) {
+		c := NewClient(WithHTTPClient(&mempoolAcceptResponse{}))
+		for _, txHexes := range [][]string{nil, {}, {""}, {testTxHex(BTC), "invalid-hex"}} {
+			results, err := c.TestMempoolAccept(context.Background(), BTC, txHexes, 0)
+			require.Error(t, err)
+			require.Nil(t, results)
+			assert.ErrorIs(t, err, ErrInvalidTxHex)
+		}
+	})
+
+	t.Run("unsupported chain", func(t *testing.T) {
+		c := NewClient(WithHTTPClient(&mempoolAcceptResponse{}))
+		for _, chain := range []Blockchain{BSV, DOGE, ETH} {
+			results, err := c.TestMempoolAccept(context.Background(), chain, []string{testTxHex(chain)}, 0)
+			require.Error(t, err)
+			require.Nil(t, results)
+			assert.ErrorIs(t, err, ErrUnsupportedBlockchain)
+		}
+	})
+
+	t.Run("error cases", func(t *testing.T) {
+		c := NewClient(WithHTTPClient(&nodeMethodResponse{}))
+		results, err := c.TestMempoolAccept(context.Background(), BTC, []string{testTxHex(BTC)}, 0)
+		require.Error(t, err)
+		require.Nil(t, results)
+
+		c = NewClient(WithHTTPClient(&errorDoReqErr{}))
+		results, err = c.TestMempoolAccept(context.Background(), BTC, []string{testTxHex(BTC)}, 0)
+		require.Error(t, err)
+		require.Nil(t, results)
+
+		c = NewClient(WithHTTPClient(&errorBadJSONResponse{}))
+		results, err = c.TestMempoolAccept(context.Background(), BTC, []string{testTxHex(BTC)}, 0)
+		require.Error(t, err)
+		require.Nil(t, results)
+	})
+}
+
+func ExampleClient_TestMempoolAccept() {
+	c := NewClient(WithHTTPClient(&mempoolAcceptResponse{rejectReason: "min relay fee not met"}))
+	results, _ := c.TestMempoolAccept(context.Background(), BTC, []string{testTxHex(BTC)}, 0)
+	fmt.Println("reject reason: " + results[0].RejectReason)
+	// Output:reject reason: min relay fee not met
+}
+
+func BenchmarkClient_TestMempoolAccept(b *testing.B) {
+	c := NewClient(WithHTTPClient(&mempoolAcceptResponse{}))
+	ctx := context.Background()
+	txHexes := []string{testTxHex(BTC)}
+	for i := 0; i < b.N; i++ {
+		_, _ = c.TestMempoolAccept(ctx, BTC, txHexes, 0)
+	}
+}
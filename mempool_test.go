@@ -1,14 +1,84 @@
 package nownodes
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"net/http"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
+const (
+	testMempoolChildTxID  = "8f1b8d6c2ff8c4e4d3a8a3d43e0d7be5c0b8b1d0e4a6b1d5c9a2e4f6b7c8d9e0"
+	testMempoolParentTxID = "1a2b3c4d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5d6e7f809"
+)
+
+// mempoolResults are the NodeAPI results for the mempool methods (by verbose flag)
+var mempoolResults = map[bool]map[string]string{
+	false: {
+		nodeMethodGetMempoolAncestors:   `["` + testMempoolParentTxID + `"]`,
+		nodeMethodGetMempoolDescendants: `["` + testMempoolChildTxID + `"]`,
+		nodeMethodGetMempoolInfo:        `{"loaded":true,"size":4186,"bytes":1987655,"usage":10256432,"total_fee":0.10423987,"maxmempool":300000000,"mempoolminfee":0.00001000,"minrelaytxfee":0.00001000,"unbroadcastcount":0}`,
+		nodeMethodGetRawMempool:         `["` + testMempoolParentTxID + `","` + testMempoolChildTxID + `"]`,
+	},
+	true: {
+		nodeMethodGetMempoolAncestors:   `{"` + testMempoolParentTxID + `":` + testMempoolParentEntry + `}`,
+		nodeMethodGetMempoolDescendants: `{"` + testMempoolChildTxID + `":` + testMempoolChildEntry + `}`,
+		nodeMethodGetRawMempool:         `{"` + testMempoolChildTxID + `":` + testMempoolChildEntry + `,"` + testMempoolParentTxID + `":` + testMempoolParentEntry + `}`,
+	},
+}
+
+// testMempoolParentEntry is a low fee (stuck) parent transaction
+const testMempoolParentEntry = `{"vsize":141,"weight":561,"fee":0.00000141,"modifiedfee":0.00000141,"time":1643661192,"height":723772,"descendantcount":2,"descendantsize":282,"descendantfees":14241,"ancestorcount":1,"ancestorsize":141,"ancestorfees":141,"wtxid":"` + testMempoolParentTxID + `","fees":{"base":0.00000141,"modified":0.00000141,"ancestor":0.00000141,"descendant":0.00014241},"depends":[],"spentby":["` + testMempoolChildTxID + `"],"bip125-replaceable":true,"unbroadcast":false}`
+
+// testMempoolChildEntry is a high fee (CPFP) child transaction
+const testMempoolChildEntry = `{"vsize":141,"weight":561,"fee":0.00014100,"modifiedfee":0.00014100,"time":1643661292,"height":723772,"descendantcount":1,"descendantsize":141,"descendantfees":14100,"ancestorcount":2,"ancestorsize":282,"ancestorfees":14241,"wtxid":"` + testMempoolChildTxID + `","fees":{"base":0.00014100,"modified":0.00014100,"ancestor":0.00014241,"descendant":0.00014100},"depends":["` + testMempoolParentTxID + `"],"spentby":[],"bip125-replaceable":false,"unbroadcast":false}`
+
+// validMempoolResponse will return the mempool results (verbose is the last param)
+type validMempoolResponse struct{}
+
+func (v *validMempoolResponse) Do(req *http.Request) (*http.Response, error) {
+	resp := new(http.Response)
+	resp.StatusCode = http.StatusBadRequest
+
+	// No req found
+	if req == nil {
+		return resp, errors.New("missing request")
+	}
+
+	var data nodePayload
+	if err := json.NewDecoder(req.Body).Decode(&data); err != nil {
+		return resp, err
+	}
+
+	// Verbose flag is always the last param
+	verbose := false
+	if len(data.Params) > 0 {
+		verbose, _ = data.Params[len(data.Params)-1].(bool)
+	}
+
+	// Valid response (known method)
+	for _, chain := range allBlockchains {
+		if result, ok := mempoolResults[verbose][data.Method]; ok && strings.Contains(req.Host, chain.NodeAPIURL()) {
+			resp.StatusCode = http.StatusOK
+			resp.Body = io.NopCloser(bytes.NewBuffer([]byte(`{"result": ` + result + `,"error": null,"id": "` + data.ID + `"}`)))
+			return resp, nil
+		}
+	}
+
+	// Unknown method
+	resp.StatusCode = http.StatusNotFound
+	resp.Body = io.NopCloser(bytes.NewBuffer([]byte(`{"result": null,"error": {"code": -32601,"message": "Method not found"},"id": "` + data.ID + `"}`)))
+	return resp, nil
+}
+
 func TestClient_GetMempoolEntry(t *testing.T) {
 	t.Parallel()
 
@@ -148,6 +218,221 @@ func TestClient_GetMempoolEntry(t *testing.T) {
 	})
 }
 
+func TestClient_GetMempoolInfo(t *testing.T) {
+	t.Parallel()
+
+	t.Run("valid cases", func(t *testing.T) {
+		c := NewClient(WithAPIKey(testKey), WithHTTPClient(&validMempoolResponse{}))
+		for _, chain := range getMempoolInfoBlockchains {
+			t.Run("chain "+chain.String()+": GetMempoolInfo()", func(t *testing.T) {
+				info, err := c.GetMempoolInfo(context.Background(), chain)
+				require.NoError(t, err)
+				require.NotNil(t, info)
+				assert.True(t, info.Loaded)
+				assert.Equal(t, uint64(4186), info.Size)
+				assert.Equal(t, uint64(1987655), info.Bytes)
+				assert.Equal(t, uint64(300000000), info.MaxMempool)
+				assert.InDelta(t, 0.00001, info.MempoolMinFee, 0.000000001)
+			})
+		}
+	})
+
+	t.Run("unsupported chain", func(t *testing.T) {
+		c := NewClient(WithHTTPClient(&validMempoolResponse{}))
+		info, err := c.GetMempoolInfo(context.Background(), ETH)
+		require.Error(t, err)
+		require.Nil(t, info)
+		assert.ErrorIs(t, err, ErrUnsupportedBlockchain)
+	})
+
+	t.Run("error cases", func(t *testing.T) {
+		c := NewClient(WithHTTPClient(&nodeMethodResponse{}))
+		info, err := c.GetMempoolInfo(context.Background(), BTC)
+		require.Error(t, err)
+		require.Nil(t, info)
+
+		c = NewClient(WithHTTPClient(&errorBadJSONResponse{}))
+		info, err = c.GetMempoolInfo(context.Background(), BTC)
+		require.Error(t, err)
+		require.Nil(t, info)
+	})
+}
+
+func TestClient_GetRawMempool(t *testing.T) {
+	t.Parallel()
+
+	t.Run("tx ids only", func(t *testing.T) {
+		c := NewClient(WithAPIKey(testKey), WithHTTPClient(&validMempoolResponse{}))
+		for _, chain := range getRawMempoolBlockchains {
+			t.Run("chain "+chain.String()+": GetRawMempool(false)", func(t *testing.T) {
+				mempool, err := c.GetRawMempool(context.Background(), chain, false)
+				require.NoError(t, err)
+				require.NotNil(t, mempool)
+				assert.Equal(t, []string{testMempoolParentTxID, testMempoolChildTxID}, mempool.TxIDs)
+				assert.Nil(t, mempool.Entries)
+			})
+		}
+	})
+
+	t.Run("verbose", func(t *testing.T) {
+		c := NewClient(WithAPIKey(testKey), WithHTTPClient(&validMempoolResponse{}))
+		mempool, err := c.GetRawMempool(context.Background(), BTC, true)
+		require.NoError(t, err)
+		require.NotNil(t, mempool)
+		assert.Equal(t, []string{testMempoolParentTxID, testMempoolChildTxID}, mempool.TxIDs)
+		require.Len(t, mempool.Entries, 2)
+
+		parent := mempool.Entries[testMempoolParentTxID]
+		require.NotNil(t, parent)
+		assert.True(t, parent.BIP125Replaceable)
+		assert.Equal(t, uint64(2), parent.DescendantCount)
+		assert.Equal(t, uint64(282), parent.DescendantSize)
+		assert.Equal(t, int64(14241), parent.DescendantFees)
+		assert.Equal(t, []string{testMempoolChildTxID}, parent.SpentBy)
+		assert.Equal(t, testMempoolParentTxID, parent.WTxID)
+		require.NotNil(t, parent.Fees)
+		assert.InDelta(t, 0.00014241, parent.Fees.Descendant, 0.000000001)
+
+		child := mempool.Entries[testMempoolChildTxID]
+		require.NotNil(t, child)
+		assert.False(t, child.BIP125Replaceable)
+		assert.Equal(t, uint64(2), child.AncestorCount)
+		assert.Equal(t, uint64(282), child.AncestorSize)
+		assert.Equal(t, int64(14241), child.AncestorFees)
+		assert.Equal(t, uint64(141), child.VSize)
+		assert.Equal(t, uint64(561), child.Weight)
+		assert.Equal(t, []string{testMempoolParentTxID}, child.Depends)
+	})
+
+	t.Run("unsupported chain", func(t *testing.T) {
+		c := NewClient(WithHTTPClient(&validMempoolResponse{}))
+		mempool, err := c.GetRawMempool(context.Background(), ETH, false)
+		require.Error(t, err)
+		require.Nil(t, mempool)
+		assert.ErrorIs(t, err, ErrUnsupportedBlockchain)
+	})
+
+	t.Run("error cases", func(t *testing.T) {
+		for _, verbose := range []bool{true, false} {
+			c := NewClient(WithHTTPClient(&nodeMethodResponse{}))
+			mempool, err := c.GetRawMempool(context.Background(), BTC, verbose)
+			require.Error(t, err)
+			require.Nil(t, mempool)
+
+			c = NewClient(WithHTTPClient(&errorDoReqErr{}))
+			mempool, err = c.GetRawMempool(context.Background(), BTC, verbose)
+			require.Error(t, err)
+			require.Nil(t, mempool)
+
+			c = NewClient(WithHTTPClient(&errorBadJSONResponse{}))
+			mempool, err = c.GetRawMempool(context.Background(), BTC, verbose)
+			require.Error(t, err)
+			require.Nil(t, mempool)
+		}
+	})
+}
+
+func TestClient_GetMempoolAncestors(t *testing.T) {
+	t.Parallel()
+
+	t.Run("valid cases", func(t *testing.T) {
+		c := NewClient(WithAPIKey(testKey), WithHTTPClient(&validMempoolResponse{}))
+		for _, chain := range getMempoolAncestorsBlockchains {
+			t.Run("chain "+chain.String()+": GetMempoolAncestors()", func(t *testing.T) {
+				mempool, err := c.GetMempoolAncestors(context.Background(), chain, testMempoolChildTxID, false)
+				require.NoError(t, err)
+				require.NotNil(t, mempool)
+				assert.Equal(t, []string{testMempoolParentTxID}, mempool.TxIDs)
+
+				mempool, err = c.GetMempoolAncestors(context.Background(), chain, testMempoolChildTxID, true)
+				require.NoError(t, err)
+				require.NotNil(t, mempool)
+				assert.Equal(t, []string{testMempoolParentTxID}, mempool.TxIDs)
+				require.Contains(t, mempool.Entries, testMempoolParentTxID)
+				assert.Equal(t, uint64(2), mempool.Entries[testMempoolParentTxID].DescendantCount)
+			})
+		}
+	})
+
+	t.Run("invalid tx id", func(t *testing.T) {
+		c := NewClient(WithHTTPClient(&validMempoolResponse{}))
+		mempool, err := c.GetMempoolAncestors(context.Background(), BTC, "12345", false)
+		require.Error(t, err)
+		require.Nil(t, mempool)
+		assert.ErrorIs(t, err, ErrInvalidTxID)
+	})
+
+	t.Run("unsupported chain", func(t *testing.T) {
+		c := NewClient(WithHTTPClient(&validMempoolResponse{}))
+		mempool, err := c.GetMempoolAncestors(context.Background(), ETH, testTxID(ETH), false)
+		require.Error(t, err)
+		require.Nil(t, mempool)
+		assert.ErrorIs(t, err, ErrUnsupportedBlockchain)
+	})
+
+	t.Run("error cases", func(t *testing.T) {
+		c := NewClient(WithHTTPClient(&nodeMethodResponse{}))
+		mempool, err := c.GetMempoolAncestors(context.Background(), BTC, testMempoolChildTxID, true)
+		require.Error(t, err)
+		require.Nil(t, mempool)
+
+		c = NewClient(WithHTTPClient(&errorDoReqErr{}))
+		mempool, err = c.GetMempoolAncestors(context.Background(), BTC, testMempoolChildTxID, false)
+		require.Error(t, err)
+		require.Nil(t, mempool)
+	})
+}
+
+func TestClient_GetMempoolDescendants(t *testing.T) {
+	t.Parallel()
+
+	t.Run("valid cases", func(t *testing.T) {
+		c := NewClient(WithAPIKey(testKey), WithHTTPClient(&validMempoolResponse{}))
+		for _, chain := range getMempoolDescendantsBlockchains {
+			t.Run("chain "+chain.String()+": GetMempoolDescendants()", func(t *testing.T) {
+				mempool, err := c.GetMempoolDescendants(context.Background(), chain, testMempoolParentTxID, false)
+				require.NoError(t, err)
+				require.NotNil(t, mempool)
+				assert.Equal(t, []string{testMempoolChildTxID}, mempool.TxIDs)
+
+				mempool, err = c.GetMempoolDescendants(context.Background(), chain, testMempoolParentTxID, true)
+				require.NoError(t, err)
+				require.NotNil(t, mempool)
+				require.Contains(t, mempool.Entries, testMempoolChildTxID)
+				assert.Equal(t, uint64(2), mempool.Entries[testMempoolChildTxID].AncestorCount)
+			})
+		}
+	})
+
+	t.Run("invalid tx id", func(t *testing.T) {
+		c := NewClient(WithHTTPClient(&validMempoolResponse{}))
+		mempool, err := c.GetMempoolDescendants(context.Background(), BTC, "", false)
+		require.Error(t, err)
+		require.Nil(t, mempool)
+		assert.ErrorIs(t, err, ErrInvalidTxID)
+	})
+
+	t.Run("unsupported chain", func(t *testing.T) {
+		c := NewClient(WithHTTPClient(&validMempoolResponse{}))
+		mempool, err := c.GetMempoolDescendants(context.Background(), ETH, testTxID(ETH), false)
+		require.Error(t, err)
+		require.Nil(t, mempool)
+		assert.ErrorIs(t, err, ErrUnsupportedBlockchain)
+	})
+
+	t.Run("error cases", func(t *testing.T) {
+		c := NewClient(WithHTTPClient(&nodeMethodResponse{}))
+		mempool, err := c.GetMempoolDescendants(context.Background(), BTC, testMempoolParentTxID, true)
+		require.Error(t, err)
+		require.Nil(t, mempool)
+
+		c = NewClient(WithHTTPClient(&errorBadJSONResponse{}))
+		mempool, err = c.GetMempoolDescendants(context.Background(), BTC, testMempoolParentTxID, false)
+		require.Error(t, err)
+		require.Nil(t, mempool)
+	})
+}
+
 func ExampleClient_GetMempoolEntry() {
 	c := NewClient(WithHTTPClient(&validNodeResponse{}))
 	info, _ := c.GetMempoolEntry(context.Background(), BSV, testTxID(BSV), testUniqueID)
@@ -163,3 +448,25 @@ func BenchmarkClient_GetMempoolEntry(b *testing.B) {
 		_, _ = c.GetMempoolEntry(ctx, BSV, tx, testUniqueID)
 	}
 }
+
+func ExampleClient_GetMempoolInfo() {
+	c := NewClient(WithHTTPClient(&validMempoolResponse{}))
+	info, _ := c.GetMempoolInfo(context.Background(), BTC)
+	fmt.Printf("mempool size: %d", info.Size)
+	// Output:mempool size: 4186
+}
+
+func ExampleClient_GetMempoolDescendants() {
+	c := NewClient(WithHTTPClient(&validMempoolResponse{}))
+	mempool, _ := c.GetMempoolDescendants(context.Background(), BTC, testMempoolParentTxID, true)
+	fmt.Printf("descendants found: %d", len(mempool.TxIDs))
+	// Output:descendants found: 1
+}
+
+func BenchmarkClient_GetRawMempool(b *testing.B) {
+	c := NewClient(WithHTTPClient(&validMempoolResponse{}))
+	ctx := context.Background()
+	for i := 0; i < b.N; i++ {
+		_, _ = c.GetRawMempool(ctx, BTC, true)
+	}
+}
@@ -1,12 +1,47 @@
 package nownodes
 
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
 // NodeError is an internal error from the NodeAPI
 type NodeError struct {
-	Error *nodeAPIError `json:"error,omitempty"` // The error message from NodeAPI requests
+	Error *RPCError `json:"error,omitempty"` // The error message from NodeAPI requests
 }
 
-// nodeError is an internal error from the NodeAPI
-type nodeAPIError struct {
+// RPCError is an error returned by the NodeAPI (bitcoind style JSON-RPC error)
+//
+// Use errors.Is() with the ErrRPC* sentinels to match well-known error codes
+type RPCError struct {
 	Code    int64  `json:"code"`    // IE: -26
 	Message string `json:"message"` // IE: 257: txn-already-known
 }
+
+// Error will return the error message (implements the error interface)
+func (e *RPCError) Error() string {
+	return fmt.Sprintf("code [%d] error [%s]", e.Code, e.Message)
+}
+
+// Is will return true if the target is a sentinel matching the error code (used by errors.Is)
+func (e *RPCError) Is(target error) bool {
+	switch {
+	case errors.Is(target, ErrRPCTxAlreadyKnown):
+		return (e.Code == rpcCodeVerifyRejected &&
+			(strings.Contains(e.Message, "txn-already-known") || strings.Contains(e.Message, "txn-already-in-mempool"))) ||
+			(e.Code == rpcCodeAlreadyInChain && strings.Contains(strings.ToLower(e.Message), "already in the mempool"))
+	case errors.Is(target, ErrRPCAlreadyInChain):
+		return e.Code == rpcCodeAlreadyInChain
+	case errors.Is(target, ErrRPCInvalidParameter):
+		return e.Code == rpcCodeInvalidParameter
+	case errors.Is(target, ErrRPCMissingInputs):
+		return e.Code == rpcCodeMissingInputs
+	case errors.Is(target, ErrRPCNotFound):
+		return e.Code == rpcCodeNotFound
+	case errors.Is(target, ErrRPCVerifyRejected):
+		return e.Code == rpcCodeVerifyRejected
+	default:
+		return false
+	}
+}
@@ -2,11 +2,17 @@ package nownodes
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"io"
 	"net/http"
 	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 // validNodeResponse will return a valid response for all supported blockchains
@@ -50,6 +56,42 @@ func (v *validNodeResponse) Do(req *http.Request) (*http.Response, error) {
 	return resp, errors.New("request not found")
 }
 
+// nodeMethodResponse will return the result (raw JSON) for the NodeAPI method for all supported blockchains
+type nodeMethodResponse struct {
+	results map[string]string // Result by method
+}
+
+func (v *nodeMethodResponse) Do(req *http.Request) (*http.Response, error) {
+	resp := new(http.Response)
+	resp.StatusCode = http.StatusBadRequest
+
+	// No req found
+	if req == nil {
+		return resp, errors.New("missing request")
+	}
+
+	decoder := json.NewDecoder(req.Body)
+	var data nodePayload
+	err := decoder.Decode(&data)
+	if err != nil {
+		return resp, err
+	}
+
+	// Valid response (known method)
+	for _, chain := range callBlockchains {
+		if result, ok := v.results[data.Method]; ok && strings.Contains(req.Host, chain.NodeAPIURL()) {
+			resp.StatusCode = http.StatusOK
+			resp.Body = io.NopCloser(bytes.NewBuffer([]byte(`{"result": ` + result + `,"error": null,"id": "` + data.ID + `"}`)))
+			return resp, nil
+		}
+	}
+
+	// Unknown method
+	resp.StatusCode = http.StatusNotFound
+	resp.Body = io.NopCloser(bytes.NewBuffer([]byte(`{"result": null,"error": {"code": -32601,"message": "Method not found"},"id": "` + data.ID + `"}`)))
+	return resp, nil
+}
+
 // errorNodeErrorResponse will return an error for the "send raw tx" response
 type errorNodeErrorResponse struct{}
 
@@ -90,3 +132,67 @@ func (v *errorNodeErrorResponse) Do(req *http.Request) (*http.Response, error) {
 	resp.Body = io.NopCloser(bytes.NewBuffer([]byte(`{"error":"no-route-found"}`)))
 	return resp, errors.New("request not found")
 }
+
+func TestRPCError_Error(t *testing.T) {
+	t.Parallel()
+
+	err := &RPCError{Code: -26, Message: "257: txn-already-known"}
+	assert.Equal(t, "code [-26] error [257: txn-already-known]", err.Error())
+}
+
+func TestRPCError_Is(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		err      *RPCError
+		target   error
+		expected bool
+	}{
+		{&RPCError{Code: -25, Message: "bad-txns-inputs-missingorspent"}, ErrRPCMissingInputs, true},
+		{&RPCError{Code: -26, Message: "66: min relay fee not met"}, ErrRPCVerifyRejected, true},
+		{&RPCError{Code: -26, Message: "66: min relay fee not met"}, ErrRPCTxAlreadyKnown, false},
+		{&RPCError{Code: -26, Message: "257: txn-already-known"}, ErrRPCVerifyRejected, true},
+		{&RPCError{Code: -26, Message: "257: txn-already-known"}, ErrRPCTxAlreadyKnown, true},
+		{&RPCError{Code: -27, Message: "Transaction already in the mempool"}, ErrRPCTxAlreadyKnown, true},
+		{&RPCError{Code: -27, Message: "transaction already in block chain"}, ErrRPCAlreadyInChain, true},
+		{&RPCError{Code: -27, Message: "transaction already in block chain"}, ErrRPCTxAlreadyKnown, false},
+		{&RPCError{Code: -5, Message: "No such mempool or blockchain transaction"}, ErrRPCNotFound, true},
+		{&RPCError{Code: -8, Message: "parameter 1 must be of length 64"}, ErrRPCInvalidParameter, true},
+		{&RPCError{Code: -8, Message: "parameter 1 must be of length 64"}, ErrRPCNotFound, false},
+		{&RPCError{Code: -5, Message: "not found"}, ErrInvalidTxID, false},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.err.Error()+" is "+testCase.target.Error(), func(t *testing.T) {
+			assert.Equal(t, testCase.expected, errors.Is(testCase.err, testCase.target))
+		})
+	}
+}
+
+func TestRPCError_Requests(t *testing.T) {
+	t.Parallel()
+
+	t.Run("already known on broadcast", func(t *testing.T) {
+		c := NewClient(WithHTTPClient(&errorNodeErrorResponse{}))
+		_, err := c.SendRawTransaction(context.Background(), BSV, testTxHex(BSV), testUniqueID)
+		require.Error(t, err)
+		assert.ErrorIs(t, err, ErrRPCTxAlreadyKnown)
+
+		var rpcErr *RPCError
+		require.ErrorAs(t, err, &rpcErr)
+		assert.Equal(t, int64(-27), rpcErr.Code)
+	})
+
+	t.Run("not found on mempool entry", func(t *testing.T) {
+		c := NewClient(WithHTTPClient(&errorNodeErrorResponse{}))
+		_, err := c.GetMempoolEntry(context.Background(), BSV, testTxID(BSV), testUniqueID)
+		require.Error(t, err)
+		assert.ErrorIs(t, err, ErrRPCNotFound)
+	})
+}
+
+func ExampleRPCError_Is() {
+	c := NewClient(WithHTTPClient(&errorNodeErrorResponse{}))
+	_, err := c.SendRawTransaction(context.Background(), BSV, testTxHex(BSV), testUniqueID)
+	fmt.Printf("already known: %t", errors.Is(err, ErrRPCTxAlreadyKnown))
+	// Output:already known: true
+}
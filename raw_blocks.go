@@ -0,0 +1,165 @@
+package nownodes
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+)
+
+// BlockVerbosity is the level of detail returned to the GetBlockRPC request
+type BlockVerbosity uint8
+
+// Block verbosity levels
+const (
+	BlockVerbosityHex   BlockVerbosity = 0 // Raw block (hex) streamed to the writer
+	BlockVerbosityTxIDs BlockVerbosity = 1 // Decoded block with the tx ids
+	BlockVerbosityTxs   BlockVerbosity = 2 // Decoded block with the decoded transactions
+)
+
+// BlockHeader is the block header returned to the GetBlockHeader request
+//
+// Only Hash and Hex are set for a non-verbose GetBlockHeader request
+type BlockHeader struct {
+	Bits              string  `json:"bits"`
+	ChainWork         string  `json:"chainwork"`
+	Confirmations     int64   `json:"confirmations"` // -1 if not on the main chain
+	Difficulty        float64 `json:"difficulty"`
+	Hash              string  `json:"hash"`
+	Height            uint64  `json:"height"`
+	Hex               string  `json:"hex,omitempty"` // Only for a non-verbose request
+	MedianTime        int64   `json:"mediantime"`
+	MerkleRoot        string  `json:"merkleroot"`
+	NextBlockHash     string  `json:"nextblockhash,omitempty"`
+	Nonce             uint64  `json:"nonce"`
+	PreviousBlockHash string  `json:"previousblockhash,omitempty"` // Empty for the genesis block
+	Time              int64   `json:"time"`
+	TxCount           uint64  `json:"nTx"`
+	Version           int64   `json:"version"`
+	VersionHex        string  `json:"versionHex"`
+}
+
+// RawBlock is the decoded block returned to the GetBlockRPC request
+//
+// TxIDs is always set, Txs is only set for BlockVerbosityTxs
+type RawBlock struct {
+	BlockHeader
+	Size         uint64            `json:"size"`
+	StrippedSize uint64            `json:"strippedsize,omitempty"` // Segwit chains
+	TxIDs        []string          `json:"-"`
+	Txs          []*RawTransaction `json:"-"`
+	Weight       uint64            `json:"weight,omitempty"` // Segwit chains
+}
+
+// GetBlockHeader will get the block header (hex) or the decoded block header (verbose) by a given block hash
+//
+// This method supports the following chains: BCH, BSV, BTC, BTCTestnet, BTG, DASH, DOGE, LTC
+func (c *Client) GetBlockHeader(ctx context.Context, chain Blockchain, hash string,
+	verbose bool) (*BlockHeader, error) {
+
+	// Validate the input
+	if !isBlockHash(hash) {
+		return nil, ErrInvalidBlock
+	}
+
+	// Hex only
+	if !verbose {
+		var headerHex string
+		if err := c.call(
+			ctx, getBlockHeaderBlockchains, chain, nodeMethodGetBlockHeader,
+			[]interface{}{hash, false}, &headerHex,
+		); err != nil {
+			return nil, err
+		}
+		return &BlockHeader{Hash: hash, Hex: headerHex}, nil
+	}
+
+	// Decoded block header
+	header := new(BlockHeader)
+	if err := c.call(
+		ctx, getBlockHeaderBlockchains, chain, nodeMethodGetBlockHeader,
+		[]interface{}{hash, true}, header,
+	); err != nil {
+		return nil, err
+	}
+	return header, nil
+}
+
+// GetBlockRPC will get the block by a given block hash from the NodeAPI
+//
+// BlockVerbosityHex will stream the raw block (hex) into the writer and only return the hash,
+// the block is never fully buffered in memory (BSV blocks can be very large), so a failure while
+// streaming (IE: a dropped connection) leaves a partial block in the writer (discard it on any error)
+// param: w is required for BlockVerbosityHex (ignored otherwise)
+// This method supports the following chains: BCH, BSV, BTC, BTCTestnet, BTG, DASH, DOGE, LTC
+// DOGE does not support BlockVerbosityTxs (the node only accepts a bool)
+func (c *Client) GetBlockRPC(ctx context.Context, chain Blockchain, hash string,
+	verbosity BlockVerbosity, w io.Writer) (*RawBlock, error) {
+
+	// Validate the input
+	if !isBlockHash(hash) {
+		return nil, ErrInvalidBlock
+	} else if verbosity > BlockVerbosityTxs || (verbosity == BlockVerbosityHex && w == nil) {
+		return nil, ErrInvalidVerbosity
+	}
+	verbosityParam, err := blockVerbosityParam(chain, verbosity)
+	if err != nil {
+		return nil, err
+	}
+
+	// Stream the raw block
+	if verbosity == BlockVerbosityHex {
+		var payload []byte
+		if payload, err = createPayload(
+			c.options.apiKey, nodeMethodGetBlock, c.nextRequestID(), []interface{}{hash, verbosityParam},
+		); err != nil {
+			return nil, err
+		}
+		if _, err = nodeStreamRequest(
+			ctx, c, getBlockRPCBlockchains, chain, payload, w,
+		); err != nil {
+			return nil, err
+		}
+		return &RawBlock{BlockHeader: BlockHeader{Hash: hash}}, nil
+	}
+
+	// Decoded block (the tx field is either tx ids or decoded transactions)
+	result := struct {
+		*RawBlock
+		Tx json.RawMessage `json:"tx"`
+	}{RawBlock: new(RawBlock)}
+	if err = c.call(
+		ctx, getBlockRPCBlockchains, chain, nodeMethodGetBlock,
+		[]interface{}{hash, verbosityParam}, &result,
+	); err != nil {
+		return nil, err
+	}
+	block := result.RawBlock
+
+	// Tx ids only
+	if verbosity == BlockVerbosityTxIDs {
+		if err = json.Unmarshal(result.Tx, &block.TxIDs); err != nil {
+			return nil, err
+		}
+		return block, nil
+	}
+
+	// Decoded transactions
+	if err = json.Unmarshal(result.Tx, &block.Txs); err != nil {
+		return nil, err
+	}
+	block.TxIDs = make([]string, 0, len(block.Txs))
+	for _, tx := range block.Txs {
+		block.TxIDs = append(block.TxIDs, tx.TxID)
+	}
+	return block, nil
+}
+
+// blockVerbosityParam will return the getblock verbosity param for the chain (older forks only accept a bool)
+func blockVerbosityParam(chain Blockchain, verbosity BlockVerbosity) (interface{}, error) {
+	if !isBlockchainSupported(getBlockRPCBoolVerbosityBlockchains, chain) {
+		return verbosity, nil
+	} else if verbosity == BlockVerbosityTxs {
+		return nil, ErrInvalidVerbosity
+	}
+	return verbosity != BlockVerbosityHex, nil
+}
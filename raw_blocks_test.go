@@ -0,0 +1,359 @@
+package nownodes
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testBlockHeaderHex  = "00e0ff3fb35e3b37f2b87d2b4c4d54dfd1b7fb42e1f7d2a40e8a04000000000000000000c1f5fd1b3c7c4a42a5e6d4b1b4dd21a8bb5d5c46b1dc4e0b1c63f8a1d4a5e7c8d6aef061e8920b1766fd4c1e"
+	testBlockHeaderJSON = `{"hash":"` + testBlockHash + `","confirmations":3,"height":723772,"version":1073733632,"versionHex":"3fffe000","merkleroot":"c8e7a5d4a1f8631c0b4edcb1465c5dbba821ddb4b1d4e6a5424a7c3c1bfdf5c1","time":1644145366,"mediantime":1644142512,"nonce":1288503654,"bits":"170b92e8","difficulty":26690525287405.5,"chainwork":"00000000000000000000000000000000000000002a4b2e1c1ce4b43a6b4c4e1e","nTx":2,"previousblockhash":"00000000000000000004a80e1fd7f2e142fbb7d1df544d4c2b7db8f2373b5eb3","nextblockhash":"0000000000000000000862a5eb8ab6b6e1a3e5d7dbb7d1d39ae2b1f0d38e0c9a"}`
+)
+
+// testBlockHex is a large raw block (bigger than the stream buffer)
+var testBlockHex = strings.Repeat(testBlockHeaderHex, 100)
+
+// validBlockRPCResponse will return the block header or block (by verbosity) for all supported blockchains
+type validBlockRPCResponse struct{}
+
+func (v *validBlockRPCResponse) Do(req *http.Request) (*http.Response, error) {
+	resp := new(http.Response)
+	resp.StatusCode = http.StatusBadRequest
+
+	// No req found
+	if req == nil {
+		return resp, errors.New("missing request")
+	}
+
+	var data nodePayload
+	if err := json.NewDecoder(req.Body).Decode(&data); err != nil {
+		return resp, err
+	}
+
+	for _, chain := range getBlockRPCBlockchains {
+		if !strings.Contains(req.Host, chain.NodeAPIURL()) {
+			continue
+		}
+
+		// Find the result by method and verbosity
+		var result string
+		switch {
+		case data.Method == nodeMethodGetBlockHeader && data.Params[1] == false:
+			result = `"` + testBlockHeaderHex + `"`
+		case data.Method == nodeMethodGetBlockHeader:
+			result = testBlockHeaderJSON
+		case data.Method == nodeMethodGetBlock && (data.Params[1] == float64(BlockVerbosityHex) || data.Params[1] == false):
+			result = `"` + testBlockHex + `"`
+		case data.Method == nodeMethodGetBlock && (data.Params[1] == float64(BlockVerbosityTxIDs) || data.Params[1] == true):
+			result = strings.TrimSuffix(testBlockHeaderJSON, `}`) + `,"size":1543,"strippedsize":1201,"weight":5146,"tx":["` + testBTCTxHexID + `","` + testTxID(BTC) + `"]}`
+		case data.Method == nodeMethodGetBlock && data.Params[1] == float64(BlockVerbosityTxs):
+			result = strings.TrimSuffix(testBlockHeaderJSON, `}`) + `,"size":1543,"strippedsize":1201,"weight":5146,"tx":[` + testDecodedBTCTx + `]}`
+		default:
+			continue
+		}
+		resp.StatusCode = http.StatusOK
+		resp.Body = io.NopCloser(bytes.NewBuffer([]byte(`{"result": ` + result + `,"error": null,"id": "` + data.ID + `"}`)))
+		return resp, nil
+	}
+
+	resp.Body = io.NopCloser(bytes.NewBuffer([]byte(`{"error":"no-route-found"}`)))
+	return resp, errors.New("request not found")
+}
+
+// rawBodyResponse will return the given status code and body for any request
+type rawBodyResponse struct {
+	body       string
+	statusCode int
+}
+
+func (v *rawBodyResponse) Do(_ *http.Request) (*http.Response, error) {
+	return &http.Response{
+		StatusCode: v.statusCode,
+		Body:       io.NopCloser(bytes.NewBufferString(v.body)),
+	}, nil
+}
+
+// errorWriter will fail on every write
+type errorWriter struct{}
+
+func (w *errorWriter) Write(_ []byte) (int, error) {
+	return 0, errors.New("write failed")
+}
+
+func TestClient_GetBlockHeader(t *testing.T) {
+	t.Parallel()
+
+	t.Run("hex only", func(t *testing.T) {
+		c := NewClient(WithAPIKey(testKey), WithHTTPClient(&validBlockRPCResponse{}))
+		for _, chain := range getBlockHeaderBlockchains {
+			t.Run("chain "+chain.String()+": GetBlockHeader(false)", func(t *testing.T) {
+				header, err := c.GetBlockHeader(context.Background(), chain, testBlockHash, false)
+				require.NoError(t, err)
+				require.NotNil(t, header)
+				assert.Equal(t, testBlockHash, header.Hash)
+				assert.Equal(t, testBlockHeaderHex, header.Hex)
+				assert.Equal(t, uint64(0), header.Height)
+			})
+		}
+	})
+
+	t.Run("verbose", func(t *testing.T) {
+		c := NewClient(WithAPIKey(testKey), WithHTTPClient(&validBlockRPCResponse{}))
+		header, err := c.GetBlockHeader(context.Background(), BTC, testBlockHash, true)
+		require.NoError(t, err)
+		require.NotNil(t, header)
+		assert.Equal(t, testBlockHash, header.Hash)
+		assert.Empty(t, header.Hex)
+		assert.Equal(t, uint64(testBlockHeight), header.Height)
+		assert.Equal(t, int64(3), header.Confirmations)
+		assert.Equal(t, "170b92e8", header.Bits)
+		assert.Equal(t, "3fffe000", header.VersionHex)
+		assert.Equal(t, uint64(1288503654), header.Nonce)
+		assert.Equal(t, uint64(2), header.TxCount)
+		assert.Equal(t, "00000000000000000004a80e1fd7f2e142fbb7d1df544d4c2b7db8f2373b5eb3", header.PreviousBlockHash)
+	})
+
+	t.Run("invalid block hash", func(t *testing.T) {
+		c := NewClient(WithHTTPClient(&validBlockRPCResponse{}))
+		for _, hash := range []string{"", "12345", testBlockHash + "00", strings.Repeat("z", bitcoinBlockHashLength)} {
+			header, err := c.GetBlockHeader(context.Background(), BTC, hash, true)
+			require.Error(t, err)
+			require.Nil(t, header)
+			assert.ErrorIs(t, err, ErrInvalidBlock)
+		}
+	})
+
+	t.Run("unsupported chain", func(t *testing.T) {
+		c := NewClient(WithHTTPClient(&validBlockRPCResponse{}))
+		header, err := c.GetBlockHeader(context.Background(), ETH, testBlockHash, true)
+		require.Error(t, err)
+		require.Nil(t, header)
+		assert.ErrorIs(t, err, ErrUnsupportedBlockchain)
+	})
+
+	t.Run("error cases", func(t *testing.T) {
+		for _, verbose := range []bool{true, false} {
+			c := NewClient(WithHTTPClient(&nodeMethodResponse{}))
+			header, err := c.GetBlockHeader(context.Background(), BTC, testBlockHash, verbose)
+			require.Error(t, err)
+			require.Nil(t, header)
+
+			c = NewClient(WithHTTPClient(&errorDoReqErr{}))
+			header, err = c.GetBlockHeader(context.Background(), BTC, testBlockHash, verbose)
+			require.Error(t, err)
+			require.Nil(t, header)
+
+			c = NewClient(WithHTTPClient(&errorBadJSONResponse{}))
+			header, err = c.GetBlockHeader(context.Background(), BTC, testBlockHash, verbose)
+			require.Error(t, err)
+			require.Nil(t, header)
+		}
+	})
+}
+
+func TestClient_GetBlockRPC(t *testing.T) {
+	t.Parallel()
+
+	t.Run("hex (streamed)", func(t *testing.T) {
+		c := NewClient(WithAPIKey(testKey), WithHTTPClient(&validBlockRPCResponse{}))
+		for _, chain := range getBlockRPCBlockchains {
+			t.Run("chain "+chain.String()+": GetBlockRPC(0)", func(t *testing.T) {
+				var buf bytes.Buffer
+				block, err := c.GetBlockRPC(context.Background(), chain, testBlockHash, BlockVerbosityHex, &buf)
+				require.NoError(t, err)
+				require.NotNil(t, block)
+				assert.Equal(t, testBlockHash, block.Hash)
+				assert.Empty(t, block.TxIDs)
+				assert.Equal(t, testBlockHex, buf.String())
+			})
+		}
+	})
+
+	t.Run("tx ids", func(t *testing.T) {
+		c := NewClient(WithAPIKey(testKey), WithHTTPClient(&validBlockRPCResponse{}))
+		block, err := c.GetBlockRPC(context.Background(), BTC, testBlockHash, BlockVerbosityTxIDs, nil)
+		require.NoError(t, err)
+		require.NotNil(t, block)
+		assert.Equal(t, testBlockHash, block.Hash)
+		assert.Equal(t, uint64(testBlockHeight), block.Height)
+		assert.Equal(t, uint64(1543), block.Size)
+		assert.Equal(t, uint64(1201), block.StrippedSize)
+		assert.Equal(t, uint64(5146), block.Weight)
+		assert.Equal(t, []string{testBTCTxHexID, testTxID(BTC)}, block.TxIDs)
+		assert.Empty(t, block.Txs)
+	})
+
+	t.Run("bool verbosity (DOGE)", func(t *testing.T) {
+		c := NewClient(WithAPIKey(testKey), WithHTTPClient(&validBlockRPCResponse{}))
+		block, err := c.GetBlockRPC(context.Background(), DOGE, testBlockHash, BlockVerbosityTxIDs, nil)
+		require.NoError(t, err)
+		require.NotNil(t, block)
+		assert.Equal(t, []string{testBTCTxHexID, testTxID(BTC)}, block.TxIDs)
+
+		block, err = c.GetBlockRPC(context.Background(), DOGE, testBlockHash, BlockVerbosityTxs, nil)
+		require.Nil(t, block)
+		assert.ErrorIs(t, err, ErrInvalidVerbosity)
+	})
+
+	t.Run("decoded txs", func(t *testing.T) {
+		c := NewClient(WithAPIKey(testKey), WithHTTPClient(&validBlockRPCResponse{}))
+		block, err := c.GetBlockRPC(context.Background(), BTC, testBlockHash, BlockVerbosityTxs, nil)
+		require.NoError(t, err)
+		require.NotNil(t, block)
+		assert.Equal(t, uint64(2), block.TxCount)
+		require.Len(t, block.Txs, 1)
+		assert.Equal(t, testBTCTxHexID, block.Txs[0].TxID)
+		require.Len(t, block.Txs[0].VOut, 2)
+		assert.Equal(t, []string{testBTCTxHexID}, block.TxIDs)
+	})
+
+	t.Run("invalid block hash", func(t *testing.T) {
+		c := NewClient(WithHTTPClient(&validBlockRPCResponse{}))
+		for _, hash := range []string{"12345", strings.Repeat("z", bitcoinBlockHashLength)} {
+			block, err := c.GetBlockRPC(context.Background(), BTC, hash, BlockVerbosityTxIDs, nil)
+			require.Error(t, err)
+			require.Nil(t, block)
+			assert.ErrorIs(t, err, ErrInvalidBlock)
+		}
+	})
+
+	t.Run("invalid verbosity", func(t *testing.T) {
+		c := NewClient(WithHTTPClient(&validBlockRPCResponse{}))
+		block, err := c.GetBlockRPC(context.Background(), BTC, testBlockHash, 3, nil)
+		require.Error(t, err)
+		require.Nil(t, block)
+		assert.ErrorIs(t, err, ErrInvalidVerbosity)
+
+		block, err = c.GetBlockRPC(context.Background(), BTC, testBlockHash, BlockVerbosityHex, nil)
+		require.Error(t, err)
+		require.Nil(t, block)
+		assert.ErrorIs(t, err, ErrInvalidVerbosity)
+	})
+
+	t.Run("unsupported chain", func(t *testing.T) {
+		c := NewClient(WithHTTPClient(&validBlockRPCResponse{}))
+		for _, verbosity := range []BlockVerbosity{BlockVerbosityHex, BlockVerbosityTxIDs} {
+			block, err := c.GetBlockRPC(context.Background(), ETH, testBlockHash, verbosity, io.Discard)
+			require.Error(t, err)
+			require.Nil(t, block)
+			assert.ErrorIs(t, err, ErrUnsupportedBlockchain)
+		}
+	})
+
+	t.Run("stream error cases", func(t *testing.T) {
+		var tests = []struct {
+			testCase string
+			client   HTTPInterface
+			writer   io.Writer
+			sentinel error
+		}{
+			{"http req error", &errorDoReqErr{}, io.Discard, nil},
+			{"missing body contents", &errorDoReqNoBodyErr{}, io.Discard, nil},
+			{"missing api key", &errorMissingAPIKey{}, io.Discard, nil},
+			{"method not found", &nodeMethodResponse{}, io.Discard, nil},
+			{"write error", &validBlockRPCResponse{}, &errorWriter{}, nil},
+			{"block not found", &rawBodyResponse{
+				statusCode: http.StatusOK,
+				body:       `{"result": null,"error": {"code": -5,"message": "Block not found"},"id": "1"}`,
+			}, io.Discard, ErrRPCNotFound},
+			{"result key in the error", &rawBodyResponse{
+				statusCode: http.StatusOK,
+				body:       `{"error": {"code": -8,"message": "Invalid parameter","data": {"result": "abc"}},"result": null,"id": "1"}`,
+			}, io.Discard, ErrRPCInvalidParameter},
+			{"not an object", &rawBodyResponse{
+				statusCode: http.StatusOK,
+				body:       `"` + testBlockHeaderHex + `"`,
+			}, io.Discard, nil},
+			{"missing result", &rawBodyResponse{
+				statusCode: http.StatusOK,
+				body:       `{"error": null,"id": "1"}`,
+			}, io.Discard, nil},
+			{"error before result", &rawBodyResponse{
+				statusCode: http.StatusOK,
+				body:       `{"error": {"code": -8,"message": "Invalid parameter"},"result": null,"id": "1"}`,
+			}, io.Discard, ErrRPCInvalidParameter},
+			{"error message", &rawBodyResponse{
+				statusCode: http.StatusOK,
+				body:       `{"message":"Too many requests"}`,
+			}, io.Discard, nil},
+			{"non string result", &rawBodyResponse{
+				statusCode: http.StatusOK,
+				body:       `{"result": {"hash": "` + testBlockHash + `"},"error": null,"id": "1"}`,
+			}, io.Discard, nil},
+			{"truncated result", &rawBodyResponse{
+				statusCode: http.StatusOK,
+				body:       `{"result": "` + testBlockHeaderHex,
+			}, io.Discard, nil},
+			{"invalid json", &rawBodyResponse{
+				statusCode: http.StatusOK,
+				body:       `{"result": null,"error": {`,
+			}, io.Discard, nil},
+		}
+
+		for _, test := range tests {
+			t.Run(test.testCase, func(t *testing.T) {
+				c := NewClient(WithHTTPClient(test.client))
+				block, err := c.GetBlockRPC(context.Background(), BTC, testBlockHash, BlockVerbosityHex, test.writer)
+				require.Error(t, err)
+				require.Nil(t, block)
+				if test.sentinel != nil {
+					assert.ErrorIs(t, err, test.sentinel)
+				}
+			})
+		}
+	})
+
+	t.Run("error cases", func(t *testing.T) {
+		c := NewClient(WithHTTPClient(&nodeMethodResponse{}))
+		block, err := c.GetBlockRPC(context.Background(), BTC, testBlockHash, BlockVerbosityTxs, nil)
+		require.Error(t, err)
+		require.Nil(t, block)
+
+		c = NewClient(WithHTTPClient(&errorBadJSONResponse{}))
+		block, err = c.GetBlockRPC(context.Background(), BTC, testBlockHash, BlockVerbosityTxIDs, nil)
+		require.Error(t, err)
+		require.Nil(t, block)
+
+		c = NewClient(WithHTTPClient(&nodeMethodResponse{results: map[string]string{
+			nodeMethodGetBlock: `{"hash":"` + testBlockHash + `","tx":"invalid"}`,
+		}}))
+		for _, verbosity := range []BlockVerbosity{BlockVerbosityTxIDs, BlockVerbosityTxs} {
+			block, err = c.GetBlockRPC(context.Background(), BTC, testBlockHash, verbosity, nil)
+			require.Error(t, err)
+			require.Nil(t, block)
+		}
+	})
+}
+
+func ExampleClient_GetBlockHeader() {
+	c := NewClient(WithHTTPClient(&validBlockRPCResponse{}))
+	header, _ := c.GetBlockHeader(context.Background(), BTC, testBlockHash, true)
+	fmt.Printf("block height: %d", header.Height)
+	// Output:block height: 723772
+}
+
+func ExampleClient_GetBlockRPC() {
+	c := NewClient(WithHTTPClient(&validBlockRPCResponse{}))
+	var buf bytes.Buffer
+	_, _ = c.GetBlockRPC(context.Background(), BTC, testBlockHash, BlockVerbosityHex, &buf)
+	fmt.Printf("block size: %d bytes", buf.Len()/2)
+	// Output:block size: 8000 bytes
+}
+
+func BenchmarkClient_GetBlockRPC(b *testing.B) {
+	c := NewClient(WithHTTPClient(&validBlockRPCResponse{}))
+	ctx := context.Background()
+	for i := 0; i < b.N; i++ {
+		_, _ = c.GetBlockRPC(ctx, BTC, testBlockHash, BlockVerbosityHex, io.Discard)
+	}
+}
@@ -0,0 +1,118 @@
+package nownodes
+
+import (
+	"context"
+)
+
+// RawTransaction is the transaction returned to the GetRawTransaction and DecodeRawTransaction requests
+//
+// Only Hex is set for a non-verbose GetRawTransaction request
+type RawTransaction struct {
+	BlockHash     string       `json:"blockhash,omitempty"`     // Only if confirmed
+	BlockTime     int64        `json:"blocktime,omitempty"`     // Only if confirmed
+	Confirmations int64        `json:"confirmations,omitempty"` // Only if confirmed
+	Hash          string       `json:"hash"`                    // Witness hash (same as TxID if no witness)
+	Hex           string       `json:"hex"`
+	LockTime      int64        `json:"locktime"`
+	Size          uint64       `json:"size"`
+	Time          int64        `json:"time,omitempty"` // Only if confirmed
+	TxID          string       `json:"txid"`
+	Version       int64        `json:"version"`
+	Vin           []*RawInput  `json:"vin"`
+	VOut          []*RawOutput `json:"vout"`
+	VSize         uint64       `json:"vsize,omitempty"`  // Segwit chains
+	Weight        uint64       `json:"weight,omitempty"` // Segwit chains
+}
+
+// RawInput is the decoded transaction input
+type RawInput struct {
+	Coinbase    string     `json:"coinbase,omitempty"` // Only for coinbase inputs
+	ScriptSig   *ScriptSig `json:"scriptSig,omitempty"`
+	Sequence    int64      `json:"sequence"`
+	TxID        string     `json:"txid,omitempty"`
+	TxInWitness []string   `json:"txinwitness,omitempty"` // Segwit chains
+	VOut        uint64     `json:"vout"`
+}
+
+// RawOutput is the decoded transaction output
+type RawOutput struct {
+	N            uint64        `json:"n"`
+	ScriptPubKey *ScriptPubKey `json:"scriptPubKey"`
+	Value        float64       `json:"value"` // In coins (not satoshis)
+}
+
+// ScriptSig is the decoded unlocking script of an input
+type ScriptSig struct {
+	Asm string `json:"asm"`
+	Hex string `json:"hex"`
+}
+
+// ScriptPubKey is the decoded locking script of an output
+type ScriptPubKey struct {
+	Address   string   `json:"address,omitempty"`   // Newer nodes (BTC v22+)
+	Addresses []string `json:"addresses,omitempty"` // Older nodes
+	Asm       string   `json:"asm"`
+	Hex       string   `json:"hex"`
+	ReqSigs   uint64   `json:"reqSigs,omitempty"` // Older nodes
+	Type      string   `json:"type"`              // IE: pubkeyhash, scripthash, witness_v0_keyhash, nulldata
+}
+
+// GetRawTransaction will get the raw transaction (hex) or the decoded transaction (verbose) by a given TxID
+//
+// This method supports the following chains: BCH, BSV, BTC, BTCTestnet, BTG, DASH, DOGE, LTC
+func (c *Client) GetRawTransaction(ctx context.Context, chain Blockchain, txID string,
+	verbose bool) (*RawTransaction, error) {
+
+	// Validate the input
+	if !chain.ValidateTxID(txID) {
+		return nil, ErrInvalidTxID
+	}
+
+	// Hex only
+	if !verbose {
+		var txHex string
+		if err := c.call(
+			ctx, getRawTransactionBlockchains, chain, nodeMethodGetRawTransaction,
+			[]interface{}{txID, 0}, &txHex,
+		); err != nil {
+			return nil, err
+		}
+		return &RawTransaction{Hex: txHex, TxID: txID}, nil
+	}
+
+	// Decoded transaction
+	tx := new(RawTransaction)
+	if err := c.call(
+		ctx, getRawTransactionBlockchains, chain, nodeMethodGetRawTransaction,
+		[]interface{}{txID, 1}, tx,
+	); err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
+
+// DecodeRawTransaction will decode the given raw transaction (hex)
+//
+// This method supports the following chains: BCH, BSV, BTC, BTCTestnet, BTG, DASH, DOGE, LTC
+func (c *Client) DecodeRawTransaction(ctx context.Context, chain Blockchain, txHex string) (*RawTransaction, error) {
+
+	// Validate the input
+	if !chain.ValidateTxHex(txHex) {
+		return nil, ErrInvalidTxHex
+	}
+
+	// Fire the HTTP request
+	tx := new(RawTransaction)
+	if err := c.call(
+		ctx, decodeRawTransactionBlockchains, chain, nodeMethodDecodeRawTransaction,
+		[]interface{}{txHex}, tx,
+	); err != nil {
+		return nil, err
+	}
+
+	// The hex is not returned by all nodes
+	if len(tx.Hex) == 0 {
+		tx.Hex = txHex
+	}
+	return tx, nil
+}
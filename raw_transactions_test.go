@@ -0,0 +1,198 @@
+package nownodes
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testDecodedBTCTx is the decoded transaction of testBTCTxHex
+const testDecodedBTCTx = `{"txid":"` + testBTCTxHexID + `","hash":"b5cc4dbb2fcd3c9cfaf6cd31c1ae1ee9d0ac1e6bd4b8e5b40f9b0fa2bcf3bb3f","version":2,"size":225,"vsize":144,"weight":573,"locktime":721214,"vin":[{"txid":"10148a2401412e3e5dc10e0396682e28e98a402c2ee5508f1b872bb6f371ee3f","vout":1,"scriptSig":{"asm":"","hex":""},"txinwitness":["3044022079cb1b845c509cac67b952170c7b2fe061729e6402767a2706398b4f8596a9160220604f3b3d64c68cd43c428da9ed480bb2d152cf7e451e26a6d9b04f818b672fb101","03eee9326b3c204620124ab38415a5aa152eef2db2cf8a6457a72b803a5dae543a"],"sequence":4294967293}],"vout":[{"value":0.00035788,"n":0,"scriptPubKey":{"asm":"0 ef0c54cd24cd6036662dab76123d133b99e58423","hex":"0014ef0c54cd24cd6036662dab76123d133b99e58423","address":"bc1qaux9fnfye5srve3d4dmpy0gn8wv7tppr7ykz65","type":"witness_v0_keyhash"}},{"value":0.00041877,"n":1,"scriptPubKey":{"asm":"OP_HASH160 200f6d0d50c82713ac1543044d695a04180ec597 OP_EQUAL","hex":"a914200f6d0d50c82713ac1543044d695a04180ec59787","address":"34AijZXtDqq8Xt45T8SeRL6HSm6rnvxy3g","type":"scripthash"}}]}`
+
+// validRawTxResponse will return a raw or decoded transaction for all supported blockchains
+type validRawTxResponse struct{}
+
+func (v *validRawTxResponse) Do(req *http.Request) (*http.Response, error) {
+	resp := new(http.Response)
+	resp.StatusCode = http.StatusBadRequest
+
+	// No req found
+	if req == nil {
+		return resp, errors.New("missing request")
+	}
+
+	var data nodePayload
+	if err := json.NewDecoder(req.Body).Decode(&data); err != nil {
+		return resp, err
+	}
+
+	for _, chain := range getRawTransactionBlockchains {
+		if !strings.Contains(req.Host, chain.NodeAPIURL()) {
+			continue
+		}
+		resp.StatusCode = http.StatusOK
+		switch {
+		case data.Method == nodeMethodGetRawTransaction && data.Params[1] == float64(0):
+			resp.Body = io.NopCloser(bytes.NewBuffer([]byte(`{"result":"` + testBTCTxHex + `","error":null,"id":"` + data.ID + `"}`)))
+		case data.Method == nodeMethodGetRawTransaction:
+			decoded := strings.TrimSuffix(testDecodedBTCTx, `}`) + `,"hex":"` + testBTCTxHex + `","blockhash":"` + testBlockHash + `","confirmations":3,"time":1643486938,"blocktime":1643486938}`
+			resp.Body = io.NopCloser(bytes.NewBuffer([]byte(`{"result":` + decoded + `,"error":null,"id":"` + data.ID + `"}`)))
+		case data.Method == nodeMethodDecodeRawTransaction:
+			resp.Body = io.NopCloser(bytes.NewBuffer([]byte(`{"result":` + testDecodedBTCTx + `,"error":null,"id":"` + data.ID + `"}`)))
+		default:
+			resp.StatusCode = http.StatusBadRequest
+			continue
+		}
+		return resp, nil
+	}
+
+	resp.Body = io.NopCloser(bytes.NewBuffer([]byte(`{"error":"no-route-found"}`)))
+	return resp, errors.New("request not found")
+}
+
+func TestClient_GetRawTransaction(t *testing.T) {
+	t.Parallel()
+
+	t.Run("hex only", func(t *testing.T) {
+		c := NewClient(WithAPIKey(testKey), WithHTTPClient(&validRawTxResponse{}))
+		for _, chain := range getRawTransactionBlockchains {
+			t.Run("chain "+chain.String()+": GetRawTransaction(false)", func(t *testing.T) {
+				tx, err := c.GetRawTransaction(context.Background(), chain, testTxID(chain), false)
+				require.NoError(t, err)
+				require.NotNil(t, tx)
+				assert.Equal(t, testBTCTxHex, tx.Hex)
+				assert.Equal(t, testTxID(chain), tx.TxID)
+				assert.Empty(t, tx.Vin)
+			})
+		}
+	})
+
+	t.Run("verbose", func(t *testing.T) {
+		c := NewClient(WithAPIKey(testKey), WithHTTPClient(&validRawTxResponse{}))
+		tx, err := c.GetRawTransaction(context.Background(), BTC, testBTCTxHexID, true)
+		require.NoError(t, err)
+		require.NotNil(t, tx)
+		assert.Equal(t, testBTCTxHexID, tx.TxID)
+		assert.Equal(t, testBTCTxHex, tx.Hex)
+		assert.Equal(t, testBlockHash, tx.BlockHash)
+		assert.Equal(t, int64(3), tx.Confirmations)
+		assert.Equal(t, uint64(144), tx.VSize)
+		require.Len(t, tx.Vin, 1)
+		assert.Len(t, tx.Vin[0].TxInWitness, 2)
+		require.Len(t, tx.VOut, 2)
+		assert.Equal(t, "witness_v0_keyhash", tx.VOut[0].ScriptPubKey.Type)
+		assert.Equal(t, "OP_HASH160 200f6d0d50c82713ac1543044d695a04180ec597 OP_EQUAL", tx.VOut[1].ScriptPubKey.Asm)
+		assert.Equal(t, "34AijZXtDqq8Xt45T8SeRL6HSm6rnvxy3g", tx.VOut[1].ScriptPubKey.Address)
+		assert.InDelta(t, 0.00041877, tx.VOut[1].Value, 0.000000001)
+	})
+
+	t.Run("invalid tx id", func(t *testing.T) {
+		c := NewClient(WithHTTPClient(&validRawTxResponse{}))
+		tx, err := c.GetRawTransaction(context.Background(), BTC, "12345", true)
+		require.Error(t, err)
+		require.Nil(t, tx)
+		assert.ErrorIs(t, err, ErrInvalidTxID)
+	})
+
+	t.Run("unsupported chain", func(t *testing.T) {
+		c := NewClient(WithHTTPClient(&validRawTxResponse{}))
+		tx, err := c.GetRawTransaction(context.Background(), ETH, testTxID(ETH), false)
+		require.Error(t, err)
+		require.Nil(t, tx)
+		assert.ErrorIs(t, err, ErrUnsupportedBlockchain)
+	})
+
+	t.Run("error cases", func(t *testing.T) {
+		for _, verbose := range []bool{true, false} {
+			c := NewClient(WithHTTPClient(&errorDoReqErr{}))
+			tx, err := c.GetRawTransaction(context.Background(), BTC, testBTCTxHexID, verbose)
+			require.Error(t, err)
+			require.Nil(t, tx)
+
+			c = NewClient(WithHTTPClient(&errorBadJSONResponse{}))
+			tx, err = c.GetRawTransaction(context.Background(), BTC, testBTCTxHexID, verbose)
+			require.Error(t, err)
+			require.Nil(t, tx)
+		}
+	})
+}
+
+func TestClient_DecodeRawTransaction(t *testing.T) {
+	t.Parallel()
+
+	t.Run("valid cases", func(t *testing.T) {
+		c := NewClient(WithAPIKey(testKey), WithHTTPClient(&validRawTxResponse{}))
+		for _, chain := range decodeRawTransactionBlockchains {
+			t.Run("chain "+chain.String()+": DecodeRawTransaction()", func(t *testing.T) {
+				tx, err := c.DecodeRawTransaction(context.Background(), chain, testBTCTxHex)
+				require.NoError(t, err)
+				require.NotNil(t, tx)
+				assert.Equal(t, testBTCTxHexID, tx.TxID)
+				assert.Equal(t, testBTCTxHex, tx.Hex)
+				assert.Equal(t, int64(721214), tx.LockTime)
+				require.Len(t, tx.VOut, 2)
+				assert.Equal(t, "0014ef0c54cd24cd6036662dab76123d133b99e58423", tx.VOut[0].ScriptPubKey.Hex)
+				assert.Equal(t, "scripthash", tx.VOut[1].ScriptPubKey.Type)
+			})
+		}
+	})
+
+	t.Run("invalid tx hex", func(t *testing.T) {
+		c := NewClient(WithHTTPClient(&validRawTxResponse{}))
+		tx, err := c.DecodeRawTransaction(context.Background(), BTC, "invalid-hex")
+		require.Error(t, err)
+		require.Nil(t, tx)
+		assert.ErrorIs(t, err, ErrInvalidTxHex)
+	})
+
+	t.Run("unsupported chain", func(t *testing.T) {
+		c := NewClient(WithHTTPClient(&validRawTxResponse{}))
+		tx, err := c.DecodeRawTransaction(context.Background(), ETH, testTxHex(ETH))
+		require.Error(t, err)
+		require.Nil(t, tx)
+		assert.ErrorIs(t, err, ErrUnsupportedBlockchain)
+	})
+
+	t.Run("error cases", func(t *testing.T) {
+		c := NewClient(WithHTTPClient(&errorDoReqErr{}))
+		tx, err := c.DecodeRawTransaction(context.Background(), BTC, testBTCTxHex)
+		require.Error(t, err)
+		require.Nil(t, tx)
+
+		c = NewClient(WithHTTPClient(&errorBadJSONResponse{}))
+		tx, err = c.DecodeRawTransaction(context.Background(), BTC, testBTCTxHex)
+		require.Error(t, err)
+		require.Nil(t, tx)
+	})
+}
+
+func ExampleClient_GetRawTransaction() {
+	c := NewClient(WithHTTPClient(&validRawTxResponse{}))
+	tx, _ := c.GetRawTransaction(context.Background(), BTC, testBTCTxHexID, true)
+	fmt.Println("output type: " + tx.VOut[0].ScriptPubKey.Type)
+	// Output:output type: witness_v0_keyhash
+}
+
+func ExampleClient_DecodeRawTransaction() {
+	c := NewClient(WithHTTPClient(&validRawTxResponse{}))
+	tx, _ := c.DecodeRawTransaction(context.Background(), BTC, testBTCTxHex)
+	fmt.Println("tx decoded: " + tx.TxID)
+	// Output:tx decoded: 4e475f486c5aad520d113448f70980f40e9b4976e4664bb3a3ed7d14c00ba639
+}
+
+func BenchmarkClient_DecodeRawTransaction(b *testing.B) {
+	c := NewClient(WithHTTPClient(&validRawTxResponse{}))
+	ctx := context.Background()
+	for i := 0; i < b.N; i++ {
+		_, _ = c.DecodeRawTransaction(ctx, BTC, testBTCTxHex)
+	}
+}
@@ -1,6 +1,7 @@
 package nownodes
 
 import (
+	"bufio"
 	"bytes"
 	"context"
 	"crypto/sha256"
@@ -11,6 +12,7 @@ import (
 	"io"
 	"io/ioutil"
 	"net/http"
+	"net/url"
 	"strings"
 )
 
@@ -36,6 +38,32 @@ type httpPayload struct {
 func httpRequest(ctx context.Context, client *Client,
 	payload *httpPayload) (response *RequestResponse) {
 
+	// Fire the http request
+	var resp *http.Response
+	if resp, response = httpDo(ctx, client, payload); response.Error != nil {
+		return
+	}
+
+	// Close the response body
+	defer func() {
+		if resp.Body != nil {
+			_ = resp.Body.Close()
+		}
+	}()
+
+	// Read the body
+	if resp.Body != nil {
+		response.BodyContents, response.Error = ioutil.ReadAll(resp.Body)
+	}
+
+	checkResponse(response)
+	return
+}
+
+// httpDo will fire the http request and return the response (the caller must close the body)
+func httpDo(ctx context.Context, client *Client,
+	payload *httpPayload) (resp *http.Response, response *RequestResponse) {
+
 	// Set reader & response
 	var bodyReader io.Reader
 	response = new(RequestResponse)
@@ -72,31 +100,26 @@ func httpRequest(ctx context.Context, client *Client,
 	}
 
 	// Fire the http request
-	var resp *http.Response
 	if resp, response.Error = client.options.httpClient.Do(request); response.Error != nil {
 		if resp != nil {
 			response.StatusCode = resp.StatusCode
+			if resp.Body != nil {
+				_ = resp.Body.Close()
+			}
 		}
-		return
+		return nil, response
 	}
 
-	// Close the response body
-	defer func() {
-		if resp != nil && resp.Body != nil {
-			_ = resp.Body.Close()
-		}
-	}()
-
 	// Set the status
 	response.StatusCode = resp.StatusCode
+	return
+}
 
-	// Read the body
-	if resp.Body != nil {
-		response.BodyContents, response.Error = ioutil.ReadAll(resp.Body)
-	}
+// checkResponse will set the error on the response (if any) from the status code and body contents
+func checkResponse(response *RequestResponse) {
 
 	// Check status code
-	if http.StatusOK == resp.StatusCode {
+	if http.StatusOK == response.StatusCode {
 
 		// Detect error message (it's not an error, and returns a 200)
 		if strings.Contains(string(response.BodyContents), `{"message":`) {
@@ -109,53 +132,53 @@ func httpRequest(ctx context.Context, client *Client,
 				response.Error = fmt.Errorf("failed to unmarshal error response: %w", err)
 				return
 			}
-			response.Error = errors.New(errBody.Error)
+			response.Error = newAPIError(response, errBody.Error)
 		}
 		return
 	}
 
 	// There's no "body" present, so just echo status code.
 	if response.BodyContents == nil {
-		response.Error = fmt.Errorf(
-			"status code: %d does not match %d",
-			resp.StatusCode, http.StatusOK,
-		)
+		response.Error = newAPIError(response, "")
 		return
 	}
 
 	// Have a "body" so map to an error type and add to the error message.
-	if payload.Method == http.MethodGet {
+	if response.Method == http.MethodGet {
 		errBody := struct {
 			Error string `json:"error"`
 		}{}
-		if err := json.Unmarshal(
-			response.BodyContents, &errBody,
-		); err != nil {
-			response.Error = fmt.Errorf("failed to unmarshal error response: %w", err)
-			return
-		}
-		response.Error = errors.New(errBody.Error)
+		_ = json.Unmarshal(response.BodyContents, &errBody) // Non-JSON bodies are kept in the error body
+		response.Error = newAPIError(response, errBody.Error)
 	} else {
 		errBody := new(NodeError)
 		if err := json.Unmarshal(
 			response.BodyContents, &errBody,
-		); err != nil {
-			response.Error = fmt.Errorf("failed to unmarshal error response: %w", err)
+		); err != nil || errBody.Error == nil {
+			response.Error = newAPIError(response, "")
 			return
 		}
-		response.Error = fmt.Errorf(
-			"code [%d] error [%s]", errBody.Error.Code, errBody.Error.Message,
-		)
+		apiErr := newAPIError(response, errBody.Error.Message)
+		apiErr.err = errBody.Error
+		response.Error = apiErr
 	}
-	return
 }
 
 // blockBookRequest will make a BlockBook request and imbue the results into the given model
 func blockBookRequest(ctx context.Context, client *Client, chains []Blockchain,
 	chain Blockchain, endpoint string, model interface{}) error {
 
+	return blockBookPathRequest(
+		ctx, client, chains, chain, apiPath+apiVersion+endpoint, model,
+	)
+}
+
+// blockBookPathRequest will make a BlockBook request (using the full path) and imbue the results into the given model
+func blockBookPathRequest(ctx context.Context, client *Client, chains []Blockchain,
+	chain Blockchain, path string, model interface{}) error {
+
 	resp, err := blockBookRequestInternal(
-		ctx, client, chains, chain, endpoint,
+		ctx, client, chains, chain, path,
 	)
 	if err != nil {
 		return err
@@ -169,7 +192,7 @@ func blockBookRequest(ctx context.Context, client *Client, chains []Blockchain,
 
 // blockBookRequestInternal will make a BlockBook request and return the result
 func blockBookRequestInternal(ctx context.Context, client *Client, chains []Blockchain,
-	chain Blockchain, endpoint string) (*RequestResponse, error) {
+	chain Blockchain, path string) (*RequestResponse, error) {
 
 	// Are we using a supported blockchain?
 	if !isBlockchainSupported(chains, chain) {
@@ -180,7 +203,7 @@ func blockBookRequestInternal(ctx context.Context, client *Client, chains []Bloc
 	resp := httpRequest(ctx, client, &httpPayload{
 		APIKey: client.options.apiKey,
 		Method: http.MethodGet,
-		URL:    httpProtocol + chain.BlockBookURL() + "/api/" + apiVersion + endpoint,
+		URL:    httpProtocol + chain.BlockBookURL() + path,
 	})
 	if resp.Error != nil {
 		return nil, resp.Error
@@ -195,7 +218,7 @@ func blockBookRequestWithNoResponse(ctx context.Context, client *Client, chains
 	chain Blockchain, endpoint string) error {
 
 	_, err := blockBookRequestInternal(
-		ctx, client, chains, chain, endpoint,
+		ctx, client, chains, chain, apiPath+apiVersion+endpoint,
 	)
 	if err != nil {
 		return err
@@ -230,25 +253,204 @@ func nodeRequest(ctx context.Context, client *Client, chains []Blockchain,
 	)
 }
 
+// nodeStreamRequest will make a NodeAPI request and stream the (string) result into the writer
+//
+// The result is never fully buffered in memory (used for large results such as raw blocks)
+func nodeStreamRequest(ctx context.Context, client *Client, chains []Blockchain,
+	chain Blockchain, payload []byte, w io.Writer) (int64, error) {
+
+	// Are we using a supported blockchain?
+	if !isBlockchainSupported(chains, chain) {
+		return 0, ErrUnsupportedBlockchain
+	}
+
+	// Fire the HTTP request
+	resp, response := httpDo(ctx, client, &httpPayload{
+		APIKey: client.options.apiKey,
+		Data:   payload,
+		Method: http.MethodPost,
+		URL:    httpProtocol + chain.NodeAPIURL(),
+	})
+	if response.Error != nil {
+		return 0, response.Error
+	}
+
+	// Close the response body
+	defer func() {
+		if resp.Body != nil {
+			_ = resp.Body.Close()
+		}
+	}()
+
+	// Errors are small, so read the whole body
+	if resp.StatusCode != http.StatusOK || resp.Body == nil {
+		if resp.Body != nil {
+			if response.BodyContents, response.Error = ioutil.ReadAll(resp.Body); response.Error != nil {
+				return 0, response.Error
+			}
+		}
+		if checkResponse(response); response.Error == nil {
+			response.Error = newAPIError(response, "")
+		}
+		return 0, response.Error
+	}
+
+	return streamResult(resp.Body, w, response)
+}
+
+// streamResult will walk the NodeAPI response (JSON tokens) and copy the "result" (string) value into the writer
+//
+// If the result is not a string, the rest of the body is read and checked for an error
+func streamResult(r io.Reader, w io.Writer, response *RequestResponse) (int64, error) {
+
+	// The response must be an object
+	dec := json.NewDecoder(r)
+	if token, err := dec.Token(); err != nil {
+		return 0, err
+	} else if token != json.Delim('{') {
+		return 0, newAPIError(response, "response is not an object")
+	}
+
+	// Walk the top level keys until the result (the other values are small)
+	fields := make(map[string]json.RawMessage)
+	for dec.More() {
+		token, err := dec.Token()
+		if err != nil {
+			return 0, err
+		}
+		if key, _ := token.(string); key != nodeResultKey {
+			var value json.RawMessage
+			if err = dec.Decode(&value); err != nil {
+				return 0, err
+			}
+			fields[key] = value
+			continue
+		}
+
+		// Continue from where the decoder stopped (it has already read ahead)
+		br := bufio.NewReader(io.MultiReader(dec.Buffered(), r))
+		var isString bool
+		if isString, err = skipToStringValue(br); err != nil {
+			return 0, err
+		} else if !isString {
+			return 0, readResponseError(fields, br, response)
+		}
+		return copyStringValue(br, w)
+	}
+	return 0, responseFieldsError(fields, response)
+}
+
+// skipToStringValue will skip the colon (and whitespace) after the key and return true if the value is a string
+//
+// The opening quote of a string value is consumed, any other value is left unread
+func skipToStringValue(r *bufio.Reader) (bool, error) {
+	for colon := false; ; {
+		b, err := r.ReadByte()
+		if err != nil {
+			return false, fmt.Errorf("failed to read result: %w", err)
+		}
+		switch {
+		case b == ' ' || b == '\t' || b == '\n' || b == '\r':
+		case b == ':' && !colon:
+			colon = true
+		case b == '"' && colon:
+			return true, nil
+		default:
+			return false, r.UnreadByte()
+		}
+	}
+}
+
+// copyStringValue will copy the string value into the writer until the closing quote (hex never contains escapes)
+func copyStringValue(r *bufio.Reader, w io.Writer) (int64, error) {
+	var written int64
+	for {
+		chunk, err := r.ReadSlice('"')
+		if errors.Is(err, bufio.ErrBufferFull) {
+			n, wErr := w.Write(chunk)
+			written += int64(n)
+			if wErr != nil {
+				return written, wErr
+			}
+			continue
+		} else if err != nil {
+			return written, fmt.Errorf("failed to read result: %w", err)
+		}
+		n, wErr := w.Write(chunk[:len(chunk)-1])
+		written += int64(n)
+		return written, wErr
+	}
+}
+
+// readResponseError will read the rest of the (non-string result) response and return the error
+func readResponseError(fields map[string]json.RawMessage, r io.Reader, response *RequestResponse) error {
+	rest, err := ioutil.ReadAll(r)
+	if err != nil {
+		return err
+	}
+
+	// The rest of the object starts at the result value
+	var tail map[string]json.RawMessage
+	if err = json.Unmarshal(append([]byte(`{"`+nodeResultKey+`":`), rest...), &tail); err != nil {
+		return err
+	}
+	for key, value := range tail {
+		fields[key] = value
+	}
+	return responseFieldsError(fields, response)
+}
+
+// responseFieldsError will return the error of a response without a string result
+func responseFieldsError(fields map[string]json.RawMessage, response *RequestResponse) error {
+	response.BodyContents, _ = json.Marshal(fields) //nolint:errchkjson // raw messages are already valid
+
+	// A 200 response with an error message
+	if raw, ok := fields["message"]; ok {
+		var message string
+		_ = json.Unmarshal(raw, &message)
+		return newAPIError(response, message)
+	}
+
+	// A NodeAPI error
+	if raw, ok := fields["error"]; ok {
+		var rpcErr *RPCError
+		if err := json.Unmarshal(raw, &rpcErr); err != nil {
+			return err
+		} else if rpcErr != nil {
+			return rpcErr
+		}
+	}
+	return newAPIError(response, "result is not a string")
+}
+
 // nodePayload is the internal raw node payload
 type nodePayload struct {
-	APIKey  string   `json:"API_key"`
-	ID      string   `json:"id"`
-	JSONRPC string   `json:"jsonrpc"`
-	Method  string   `json:"method"`
-	Params  []string `json:"params"`
+	APIKey  string        `json:"API_key"`
+	ID      string        `json:"id"`
+	JSONRPC string        `json:"jsonrpc"`
+	Method  string        `json:"method"`
+	Params  []interface{} `json:"params"`
 }
 
 // createPayload will create the JSON payload for the NodeAPI requests
-func createPayload(apiKey, method, id string, params []string) []byte {
-	b, _ := json.Marshal(nodePayload{ //nolint:errchkjson // not going to produce an error
+//
+// The params can be given by the caller (IE: Call), so an invalid value (NaN) is returned as an error
+func createPayload(apiKey, method, id string, params []interface{}) ([]byte, error) {
+	return json.Marshal(nodePayload{
 		APIKey:  apiKey,
 		JSONRPC: "2.0",
 		ID:      id,
 		Method:  method,
 		Params:  params,
 	})
-	return b
+}
+
+// encodeQuery will return the encoded url query string (with a leading "?") or empty if no values
+func encodeQuery(values url.Values) string {
+	if len(values) == 0 {
+		return ""
+	}
+	return "?" + values.Encode()
 }
 
 // hashString will generate a hash of the given string
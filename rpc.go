@@ -0,0 +1,77 @@
+package nownodes
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// rpcResponse is the generic NodeAPI (JSON-RPC) response envelope
+type rpcResponse struct {
+	NodeError
+	ID     string          `json:"id,omitempty"`
+	Result json.RawMessage `json:"result,omitempty"`
+}
+
+// Call will invoke any NodeAPI (JSON-RPC) method and imbue the result into the given model
+//
+// param: result is optional (nil will discard the result)
+// This method supports the following chains: BCH, BSV, BTC, BTCTestnet, BTG, DASH, DOGE, LTC
+func (c *Client) Call(ctx context.Context, chain Blockchain, method string,
+	params []interface{}, result interface{}) error {
+	return c.call(ctx, callBlockchains, chain, method, params, result)
+}
+
+// CallRaw will invoke any NodeAPI (JSON-RPC) method and return the raw result
+//
+// This method supports the following chains: BCH, BSV, BTC, BTCTestnet, BTG, DASH, DOGE, LTC
+func (c *Client) CallRaw(ctx context.Context, chain Blockchain, method string,
+	params []interface{}) (json.RawMessage, error) {
+	return c.callRaw(ctx, callBlockchains, chain, method, params)
+}
+
+// call will invoke the NodeAPI method (if the chain is supported) and imbue the result into the given model
+func (c *Client) call(ctx context.Context, chains []Blockchain, chain Blockchain, method string,
+	params []interface{}, result interface{}) error {
+
+	raw, err := c.callRaw(ctx, chains, chain, method, params)
+	if err != nil {
+		return err
+	} else if result == nil || len(raw) == 0 {
+		return nil
+	}
+
+	// Unmarshal the result
+	return json.Unmarshal(raw, result)
+}
+
+// callRaw will invoke the NodeAPI method (if the chain is supported) and return the raw result
+func (c *Client) callRaw(ctx context.Context, chains []Blockchain, chain Blockchain, method string,
+	params []interface{}) (json.RawMessage, error) {
+
+	// Validate the input
+	if len(method) == 0 {
+		return nil, ErrInvalidMethod
+	}
+
+	// The node expects an array (not null)
+	if params == nil {
+		params = []interface{}{}
+	}
+
+	// Create the payload
+	payload, err := createPayload(c.options.apiKey, method, c.nextRequestID(), params)
+	if err != nil {
+		return nil, err
+	}
+
+	// Fire the HTTP request
+	resp := new(rpcResponse)
+	if err = nodeRequest(
+		ctx, c, chains, chain, payload, &resp,
+	); err != nil {
+		return nil, err
+	} else if resp.Error != nil {
+		return nil, resp.Error
+	}
+	return resp.Result, nil
+}
@@ -0,0 +1,224 @@
+package nownodes
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// validCallResponse will echo the id, method and params for all supported blockchains
+type validCallResponse struct{}
+
+func (v *validCallResponse) Do(req *http.Request) (*http.Response, error) {
+	resp := new(http.Response)
+	resp.StatusCode = http.StatusBadRequest
+
+	// No req found
+	if req == nil {
+		return resp, errors.New("missing request")
+	}
+
+	var data nodePayload
+	if err := json.NewDecoder(req.Body).Decode(&data); err != nil {
+		return resp, err
+	}
+	params, _ := json.Marshal(data.Params) //nolint:errchkjson // not going to produce an error
+
+	// Valid response (any method)
+	for _, chain := range callBlockchains {
+		if strings.Contains(req.Host, chain.NodeAPIURL()) {
+			switch data.Method {
+			case "getblockcount":
+				resp.StatusCode = http.StatusOK
+				resp.Body = io.NopCloser(bytes.NewBuffer([]byte(`{"result":723772,"error":null,"id":"` + data.ID + `"}`)))
+			case "ping":
+				resp.StatusCode = http.StatusOK
+				resp.Body = io.NopCloser(bytes.NewBuffer([]byte(`{"result":null,"error":null,"id":"` + data.ID + `"}`)))
+			case "invalidmethod":
+				resp.StatusCode = http.StatusNotFound
+				resp.Body = io.NopCloser(bytes.NewBuffer([]byte(`{"result":null,"error":{"code":-32601,"message":"Method not found"},"id":"` + data.ID + `"}`)))
+			case "okwitherror":
+				resp.StatusCode = http.StatusOK
+				resp.Body = io.NopCloser(bytes.NewBuffer([]byte(`{"result":null,"error":{"code":-8,"message":"Invalid parameter"},"id":"` + data.ID + `"}`)))
+			default:
+				resp.StatusCode = http.StatusOK
+				resp.Body = io.NopCloser(bytes.NewBuffer([]byte(`{"result":{"id":"` + data.ID + `","method":"` + data.Method + `","params":` + string(params) + `},"error":null,"id":"` + data.ID + `"}`)))
+			}
+			return resp, nil
+		}
+	}
+
+	resp.Body = io.NopCloser(bytes.NewBuffer([]byte(`{"error":"no-route-found"}`)))
+	return resp, errors.New("request not found")
+}
+
+func TestClient_Call(t *testing.T) {
+	t.Parallel()
+
+	t.Run("valid cases", func(t *testing.T) {
+		c := NewClient(WithAPIKey(testKey), WithHTTPClient(&validCallResponse{}))
+		ctx := context.Background()
+
+		for _, chain := range callBlockchains {
+			t.Run("chain "+chain.String()+": Call(getblockcount)", func(t *testing.T) {
+				var height uint64
+				err := c.Call(ctx, chain, "getblockcount", nil, &height)
+				require.NoError(t, err)
+				assert.Equal(t, uint64(723772), height)
+			})
+		}
+	})
+
+	t.Run("typed params", func(t *testing.T) {
+		c := NewClient(WithHTTPClient(&validCallResponse{}))
+		var result struct {
+			Method string        `json:"method"`
+			Params []interface{} `json:"params"`
+		}
+		err := c.Call(context.Background(), BTC, "getrawtransaction", []interface{}{testTxID(BTC), true}, &result)
+		require.NoError(t, err)
+		assert.Equal(t, "getrawtransaction", result.Method)
+		assert.Equal(t, []interface{}{testTxID(BTC), true}, result.Params)
+	})
+
+	t.Run("request ids", func(t *testing.T) {
+		c := NewClient(WithHTTPClient(&validCallResponse{}))
+		for _, expected := range []string{"1", "2"} {
+			var result struct {
+				ID string `json:"id"`
+			}
+			require.NoError(t, c.Call(context.Background(), BTC, "getnetworkinfo", nil, &result))
+			assert.Equal(t, expected, result.ID)
+		}
+	})
+
+	t.Run("invalid params", func(t *testing.T) {
+		c := NewClient(WithHTTPClient(&errorDoReqErr{}))
+		err := c.Call(context.Background(), BSV, "getblockcount", []interface{}{math.NaN()}, nil)
+		require.Error(t, err)
+		var jsonErr *json.UnsupportedValueError
+		assert.ErrorAs(t, err, &jsonErr)
+	})
+
+	t.Run("nil result", func(t *testing.T) {
+		c := NewClient(WithHTTPClient(&validCallResponse{}))
+		err := c.Call(context.Background(), BTC, "ping", nil, nil)
+		require.NoError(t, err)
+	})
+
+	t.Run("node error (http error)", func(t *testing.T) {
+		c := NewClient(WithHTTPClient(&validCallResponse{}))
+		err := c.Call(context.Background(), BTC, "invalidmethod", nil, nil)
+		require.Error(t, err)
+		var nodeErr *RPCError
+		require.ErrorAs(t, err, &nodeErr)
+		assert.Equal(t, int64(-32601), nodeErr.Code)
+		assert.Equal(t, "Method not found", nodeErr.Message)
+		assert.Equal(t, "status code [404] error [Method not found]", err.Error())
+
+		var apiErr *APIError
+		require.ErrorAs(t, err, &apiErr)
+		assert.Equal(t, http.StatusNotFound, apiErr.StatusCode)
+		assert.Equal(t, http.MethodPost, apiErr.Method)
+		assert.Contains(t, apiErr.URL, BTC.NodeAPIURL())
+	})
+
+	t.Run("node error (http ok)", func(t *testing.T) {
+		c := NewClient(WithHTTPClient(&validCallResponse{}))
+		var result interface{}
+		err := c.Call(context.Background(), BTC, "okwitherror", nil, &result)
+		require.Error(t, err)
+		var nodeErr *RPCError
+		require.ErrorAs(t, err, &nodeErr)
+		assert.Equal(t, int64(-8), nodeErr.Code)
+	})
+
+	t.Run("missing method", func(t *testing.T) {
+		c := NewClient(WithHTTPClient(&validCallResponse{}))
+		err := c.Call(context.Background(), BTC, "", nil, nil)
+		require.Error(t, err)
+		assert.ErrorIs(t, err, ErrInvalidMethod)
+	})
+
+	t.Run("unsupported chain", func(t *testing.T) {
+		c := NewClient(WithHTTPClient(&validCallResponse{}))
+		err := c.Call(context.Background(), ETH, "getblockcount", nil, nil)
+		require.Error(t, err)
+		assert.ErrorIs(t, err, ErrUnsupportedBlockchain)
+	})
+
+	t.Run("error cases", func(t *testing.T) {
+		for _, chain := range callBlockchains {
+			t.Run("chain "+chain.String()+": http req error", func(t *testing.T) {
+				c := NewClient(WithHTTPClient(&errorDoReqErr{}))
+				err := c.Call(context.Background(), chain, "getblockcount", nil, nil)
+				require.Error(t, err)
+			})
+
+			t.Run("chain "+chain.String()+": invalid json response", func(t *testing.T) {
+				c := NewClient(WithHTTPClient(&errorBadJSONResponse{}))
+				err := c.Call(context.Background(), chain, "getblockcount", nil, nil)
+				require.Error(t, err)
+			})
+
+			t.Run("chain "+chain.String()+": missing body contents", func(t *testing.T) {
+				c := NewClient(WithHTTPClient(&errorDoReqNoBodyErr{}))
+				err := c.Call(context.Background(), chain, "getblockcount", nil, nil)
+				require.Error(t, err)
+			})
+		}
+	})
+}
+
+func TestClient_CallRaw(t *testing.T) {
+	t.Parallel()
+
+	t.Run("valid case", func(t *testing.T) {
+		c := NewClient(WithHTTPClient(&validCallResponse{}))
+		raw, err := c.CallRaw(context.Background(), BSV, "getblockcount", nil)
+		require.NoError(t, err)
+		assert.Equal(t, json.RawMessage(`723772`), raw)
+	})
+
+	t.Run("node error", func(t *testing.T) {
+		c := NewClient(WithHTTPClient(&validCallResponse{}))
+		raw, err := c.CallRaw(context.Background(), BSV, "okwitherror", nil)
+		require.Error(t, err)
+		require.Nil(t, raw)
+	})
+
+	t.Run("missing method", func(t *testing.T) {
+		c := NewClient(WithHTTPClient(&validCallResponse{}))
+		raw, err := c.CallRaw(context.Background(), BSV, "", nil)
+		require.Error(t, err)
+		require.Nil(t, raw)
+		assert.ErrorIs(t, err, ErrInvalidMethod)
+	})
+}
+
+func ExampleClient_Call() {
+	c := NewClient(WithHTTPClient(&validCallResponse{}))
+	var height uint64
+	_ = c.Call(context.Background(), BSV, "getblockcount", nil, &height)
+	fmt.Printf("block count: %d", height)
+	// Output:block count: 723772
+}
+
+func BenchmarkClient_Call(b *testing.B) {
+	c := NewClient(WithHTTPClient(&validCallResponse{}))
+	ctx := context.Background()
+	var height uint64
+	for i := 0; i < b.N; i++ {
+		_ = c.Call(ctx, BSV, "getblockcount", nil, &height)
+	}
+}
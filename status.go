@@ -0,0 +1,79 @@
+package nownodes
+
+import (
+	"context"
+)
+
+// StatusInfo is the status information returned to the GetStatus request
+type StatusInfo struct {
+	Backend   *BackendStatus   `json:"backend"`
+	BlockBook *BlockBookStatus `json:"blockbook"`
+}
+
+// BlockBookStatus is the status of the BlockBook indexer
+type BlockBookStatus struct {
+	About           string `json:"about"`
+	BestHeight      uint64 `json:"bestHeight"`
+	BuildTime       string `json:"buildTime"`
+	Coin            string `json:"coin"`
+	DBSize          uint64 `json:"dbSize"`
+	Decimals        uint64 `json:"decimals"`
+	GitCommit       string `json:"gitCommit"`
+	Host            string `json:"host"`
+	InitialSync     bool   `json:"initialSync"`
+	InSync          bool   `json:"inSync"`
+	InSyncMempool   bool   `json:"inSyncMempool"`
+	LastBlockTime   string `json:"lastBlockTime"`
+	LastMempoolTime string `json:"lastMempoolTime"`
+	MempoolSize     uint64 `json:"mempoolSize"`
+	SyncMode        bool   `json:"syncMode"`
+	Version         string `json:"version"`
+}
+
+// BackendStatus is the status of the blockchain node behind BlockBook
+type BackendStatus struct {
+	BestBlockHash   string `json:"bestBlockHash"`
+	Blocks          uint64 `json:"blocks"`
+	Chain           string `json:"chain"`
+	Difficulty      string `json:"difficulty"`
+	Headers         uint64 `json:"headers"`
+	ProtocolVersion string `json:"protocolVersion"`
+	SizeOnDisk      uint64 `json:"sizeOnDisk"`
+	Subversion      string `json:"subversion"`
+	TimeOffset      int64  `json:"timeOffset"`
+	Version         string `json:"version"`
+	Warnings        string `json:"warnings"`
+}
+
+// GetStatus will get the status of the BlockBook indexer and the backend node
+//
+// This method supports the following chains: BCH, BSV, BTC, BTCTestnet, BTG, DASH, DOGE, LTC
+func (c *Client) GetStatus(ctx context.Context, chain Blockchain) (*StatusInfo, error) {
+
+	// Fire the HTTP request
+	info := new(StatusInfo)
+	if err := blockBookPathRequest(
+		ctx, c, getStatusBlockchains, chain, routeStatus, &info,
+	); err != nil {
+		return nil, err
+	}
+	return info, nil
+}
+
+// IsInSync will return true if BlockBook is synced and at the same height as the backend node
+func (s *StatusInfo) IsInSync() bool {
+	if s == nil || s.BlockBook == nil || s.Backend == nil {
+		return false
+	}
+	return s.BlockBook.InSync && s.Backend.Blocks == s.Backend.Headers &&
+		s.BlockBook.BestHeight == s.Backend.Blocks
+}
+
+// HeightLag will return how many blocks BlockBook is behind the backend node (0 if not behind)
+func (s *StatusInfo) HeightLag() uint64 {
+	if s == nil || s.BlockBook == nil || s.Backend == nil ||
+		s.BlockBook.BestHeight >= s.Backend.Blocks {
+		return 0
+	}
+	return s.Backend.Blocks - s.BlockBook.BestHeight
+}
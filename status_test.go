@@ -0,0 +1,154 @@
+package nownodes
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// validStatusResponse will return a valid status for all supported blockchains
+type validStatusResponse struct{}
+
+func (v *validStatusResponse) Do(req *http.Request) (*http.Response, error) {
+	resp := new(http.Response)
+	resp.StatusCode = http.StatusBadRequest
+
+	// No req found
+	if req == nil {
+		return resp, errors.New("missing request")
+	}
+
+	// Valid response
+	for _, chain := range getStatusBlockchains {
+		if strings.Contains(req.Host, chain.BlockBookURL()) && req.URL.Path == routeStatus {
+			resp.StatusCode = http.StatusOK
+			resp.Body = io.NopCloser(bytes.NewBuffer([]byte(`{"blockbook":{"coin":"Bitcoin","host":"blockbook","version":"0.3.6","gitCommit":"6d2b6d1","buildTime":"2022-01-10T10:30:15+00:00","syncMode":true,"initialSync":false,"inSync":true,"bestHeight":723772,"lastBlockTime":"2022-01-25T11:56:32.120193829Z","inSyncMempool":true,"lastMempoolTime":"2022-01-25T12:01:10.383561294Z","mempoolSize":4512,"decimals":8,"dbSize":421532165381,"about":"Blockbook - blockchain indexer for Trezor wallet https://trezor.io/. Do not use for any other purpose."},"backend":{"chain":"main","blocks":723772,"headers":723772,"bestBlockHash":"00000000000000000a032702d724591574cae47e729acdaf8b8a990adda3f72e","difficulty":"26690525287405.5","sizeOnDisk":438560474528,"version":"220000","subversion":"/Satoshi:22.0.0/","protocolVersion":"70016","timeOffset":0,"warnings":""}}`)))
+			return resp, nil
+		}
+	}
+
+	resp.Body = io.NopCloser(bytes.NewBuffer([]byte(`{"error":"no-route-found"}`)))
+	return resp, errors.New("request not found")
+}
+
+func TestClient_GetStatus(t *testing.T) {
+	t.Parallel()
+
+	t.Run("valid cases", func(t *testing.T) {
+		c := NewClient(WithAPIKey(testKey), WithHTTPClient(&validStatusResponse{}))
+		ctx := context.Background()
+
+		for _, chain := range getStatusBlockchains {
+			t.Run("chain "+chain.String()+": GetStatus()", func(t *testing.T) {
+				info, err := c.GetStatus(ctx, chain)
+				require.NoError(t, err)
+				require.NotNil(t, info)
+				require.NotNil(t, info.BlockBook)
+				require.NotNil(t, info.Backend)
+
+				assert.Equal(t, uint64(723772), info.BlockBook.BestHeight)
+				assert.True(t, info.BlockBook.InSync)
+				assert.True(t, info.BlockBook.InSyncMempool)
+				assert.Equal(t, uint64(4512), info.BlockBook.MempoolSize)
+				assert.Equal(t, "0.3.6", info.BlockBook.Version)
+				assert.Equal(t, "2022-01-25T11:56:32.120193829Z", info.BlockBook.LastBlockTime)
+				assert.Equal(t, uint64(723772), info.Backend.Blocks)
+				assert.Equal(t, "/Satoshi:22.0.0/", info.Backend.Subversion)
+				assert.True(t, info.IsInSync())
+				assert.Equal(t, uint64(0), info.HeightLag())
+			})
+		}
+	})
+
+	t.Run("unsupported chain", func(t *testing.T) {
+		c := NewClient(WithHTTPClient(&validStatusResponse{}))
+		info, err := c.GetStatus(context.Background(), ETH)
+		require.Error(t, err)
+		require.Nil(t, info)
+		assert.ErrorIs(t, err, ErrUnsupportedBlockchain)
+	})
+
+	t.Run("error cases", func(t *testing.T) {
+		for _, chain := range getStatusBlockchains {
+			t.Run("chain "+chain.String()+": http req error", func(t *testing.T) {
+				c := NewClient(WithHTTPClient(&errorDoReqErr{}))
+				info, err := c.GetStatus(context.Background(), chain)
+				require.Error(t, err)
+				require.Nil(t, info)
+			})
+
+			t.Run("chain "+chain.String()+": invalid json response", func(t *testing.T) {
+				c := NewClient(WithHTTPClient(&errorBadJSONResponse{}))
+				info, err := c.GetStatus(context.Background(), chain)
+				require.Error(t, err)
+				require.Nil(t, info)
+			})
+
+			t.Run("chain "+chain.String()+": missing api key", func(t *testing.T) {
+				c := NewClient(WithHTTPClient(&errorMissingAPIKey{}))
+				info, err := c.GetStatus(context.Background(), chain)
+				require.Error(t, err)
+				require.Nil(t, info)
+			})
+		}
+	})
+}
+
+func TestStatusInfo_IsInSync(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		name   string
+		status *StatusInfo
+		inSync bool
+		lag    uint64
+	}{
+		{"nil status", nil, false, 0},
+		{"missing backend", &StatusInfo{BlockBook: &BlockBookStatus{InSync: true}}, false, 0},
+		{"in sync", &StatusInfo{
+			BlockBook: &BlockBookStatus{InSync: true, BestHeight: 100},
+			Backend:   &BackendStatus{Blocks: 100, Headers: 100},
+		}, true, 0},
+		{"blockbook lagging", &StatusInfo{
+			BlockBook: &BlockBookStatus{InSync: true, BestHeight: 97},
+			Backend:   &BackendStatus{Blocks: 100, Headers: 100},
+		}, false, 3},
+		{"blockbook not synced", &StatusInfo{
+			BlockBook: &BlockBookStatus{InSync: false, BestHeight: 100},
+			Backend:   &BackendStatus{Blocks: 100, Headers: 100},
+		}, false, 0},
+		{"backend syncing headers", &StatusInfo{
+			BlockBook: &BlockBookStatus{InSync: true, BestHeight: 100},
+			Backend:   &BackendStatus{Blocks: 100, Headers: 105},
+		}, false, 0},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.inSync, testCase.status.IsInSync())
+			assert.Equal(t, testCase.lag, testCase.status.HeightLag())
+		})
+	}
+}
+
+func ExampleClient_GetStatus() {
+	c := NewClient(WithHTTPClient(&validStatusResponse{}))
+	info, _ := c.GetStatus(context.Background(), BTC)
+	fmt.Printf("in sync: %t", info.IsInSync())
+	// Output:in sync: true
+}
+
+func BenchmarkClient_GetStatus(b *testing.B) {
+	c := NewClient(WithHTTPClient(&validStatusResponse{}))
+	ctx := context.Background()
+	for i := 0; i < b.N; i++ {
+		_, _ = c.GetStatus(ctx, BTC)
+	}
+}
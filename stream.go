@@ -0,0 +1,615 @@
+package nownodes
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// StreamClient is a Blockbook WebSocket client (subscriptions and request/response calls)
+//
+// The connection is opened on first use, kept alive with pings and re-opened (with back-off)
+// if it drops, all active subscriptions are re-subscribed after a reconnect
+type StreamClient struct {
+	chain         Blockchain                      // Blockchain for the connection
+	client        *Client                         // Client (API key and user agent)
+	conn          *streamConn                     // Current connection (nil if not connected)
+	connectMu     sync.Mutex                      // Only one dial at a time
+	closed        bool                            // Closed by the user
+	done          chan struct{}                   // Closed when the stream is closed
+	lastID        uint64                          // Last request ID
+	mu            sync.RWMutex                    // Guards the connection, pending requests and subscriptions
+	options       *StreamOptions                  // Options for the stream
+	pending       map[string]chan *streamResponse // Pending requests by ID
+	subscribeMu   sync.Mutex                      // Only one (un)subscribe request at a time
+	subscriptions map[string]*streamSubscription  // Active subscriptions by ID
+}
+
+// StreamOptions holds all the configuration for the Blockbook WebSocket stream
+type StreamOptions struct {
+	BackOffExponentFactor float64       `json:"back_off_exponent_factor"`
+	BackOffInitialTimeout time.Duration `json:"back_off_initial_timeout"`
+	BackOffMaxTimeout     time.Duration `json:"back_off_max_timeout"`
+	BufferSize            int           `json:"buffer_size"` // Events buffered per subscriber (min 1, the oldest are dropped when full)
+	DialTimeout           time.Duration `json:"dial_timeout"`
+	MaxAddresses          int           `json:"max_addresses"` // Max addresses of all address subscriptions (zero will disable)
+	PingInterval          time.Duration `json:"ping_interval"` // Zero will disable (the connection is dropped if no pong after two intervals)
+	URL                   string        `json:"url"`           // Overwrite the WebSocket url (IE: a self-hosted Blockbook)
+}
+
+// StreamError is an error returned by Blockbook for a WebSocket request
+type StreamError struct {
+	Message string `json:"message"` // IE: Transaction 'abc' not found
+	Method  string `json:"method"`  // IE: getTransaction
+}
+
+// Error will return the error message (implements the error interface)
+func (e *StreamError) Error() string {
+	return fmt.Sprintf("method [%s] error [%s]", e.Method, e.Message)
+}
+
+// Is will return true if the target is a sentinel matching the error (used by errors.Is)
+//
+// There is no status code over the WebSocket, the message is matched the same as an APIError message
+func (e *StreamError) Is(target error) bool {
+	return errors.Is(errorSentinel(0, e.Message), target)
+}
+
+// streamConn is a single WebSocket connection
+type streamConn struct {
+	done    chan struct{}   // Closed when the connection is dropped
+	writeMu sync.Mutex      // Only one writer at a time
+	ws      *websocket.Conn // WebSocket connection
+}
+
+// streamRequest is the request sent over the WebSocket
+type streamRequest struct {
+	ID     string      `json:"id"`
+	Method string      `json:"method"`
+	Params interface{} `json:"params"`
+}
+
+// streamResponse is a response (or subscription notification) received over the WebSocket
+type streamResponse struct {
+	Data json.RawMessage `json:"data"`
+	ID   string          `json:"id"`
+}
+
+// streamSubscription is an active subscription (one per type per connection)
+type streamSubscription struct {
+	listeners   map[*streamListener]struct{} // Subscribers receiving the notifications
+	method      string                       // IE: subscribeNewBlock
+	params      func() interface{}           // Params for every (re)subscribe (called with the lock held)
+	unsubscribe string                       // IE: unsubscribeNewBlock
+}
+
+// streamListener is a single subscriber (notifications are delivered until the context is done)
+//
+// Notifications are queued without blocking the connection, a subscriber that falls behind
+// loses the oldest notifications once the buffer is full
+type streamListener struct {
+	addresses map[string]struct{} // Only for address subscriptions (guarded by the stream lock)
+	ctx       context.Context
+	in        chan json.RawMessage
+}
+
+// NewStreamClient will create a new Blockbook WebSocket client for the given blockchain
+//
+// The connection is opened on first use (options are optional, nil will use the defaults)
+// This method supports the following chains: BCH, BSV, BTC, BTCTestnet, BTG, DASH, DOGE, LTC
+func (c *Client) NewStreamClient(chain Blockchain, options *StreamOptions) *StreamClient {
+	if options == nil {
+		options = DefaultStreamOptions()
+	}
+	return &StreamClient{
+		chain:         chain,
+		client:        c,
+		done:          make(chan struct{}),
+		options:       options,
+		pending:       make(map[string]chan *streamResponse),
+		subscriptions: make(map[string]*streamSubscription),
+	}
+}
+
+// Close will close the connection and end all subscriptions (the stream cannot be re-used)
+func (s *StreamClient) Close() error {
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		return nil
+	}
+	s.closed = true
+	close(s.done)
+	conn := s.conn
+	s.conn = nil
+	s.failPending()
+	s.mu.Unlock()
+
+	if conn == nil {
+		return nil
+	}
+	conn.writeMu.Lock()
+	_ = conn.ws.WriteControl(
+		websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""),
+		time.Now().Add(time.Second),
+	)
+	conn.writeMu.Unlock()
+	return conn.ws.Close()
+}
+
+// IsConnected will return true if the WebSocket connection is currently open
+func (s *StreamClient) IsConnected() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.conn != nil
+}
+
+// URL will return the WebSocket url for the stream
+func (s *StreamClient) URL() string {
+	if len(s.options.URL) > 0 {
+		return s.options.URL
+	}
+	return wsProtocol + s.chain.BlockBookURL() + routeWebSocket
+}
+
+// Ping will send a ping request over the WebSocket and wait for the response (opens the connection if needed)
+func (s *StreamClient) Ping(ctx context.Context) error {
+	return s.request(ctx, streamMethodPing, nil, nil)
+}
+
+// request will send a request over the WebSocket and imbue the result into the given model
+func (s *StreamClient) request(ctx context.Context, method string, params, result interface{}) error {
+	return s.requestWithID(ctx, strconv.FormatUint(s.nextID(), 10), method, params, result)
+}
+
+// requestWithID will send a request (using the given ID) and imbue the result into the given model
+func (s *StreamClient) requestWithID(ctx context.Context, id, method string, params, result interface{}) error {
+
+	// Open the connection (if needed)
+	conn, err := s.connect(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Register for the response
+	respCh := make(chan *streamResponse, 1)
+	s.mu.Lock()
+	s.pending[id] = respCh
+	s.mu.Unlock()
+	defer func() {
+		s.mu.Lock()
+		delete(s.pending, id)
+		s.mu.Unlock()
+	}()
+
+	// Blockbook expects an object (not null)
+	if params == nil {
+		params = struct{}{}
+	}
+	if err = s.write(conn, &streamRequest{ID: id, Method: method, Params: params}); err != nil {
+		return err
+	}
+
+	// Wait for the response
+	var resp *streamResponse
+	select {
+	case resp = <-respCh:
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-s.done:
+		return ErrStreamClosed
+	}
+	if resp == nil {
+		return ErrStreamDisconnected
+	}
+
+	// Detect an error response
+	errResp := struct {
+		Error *StreamError `json:"error"`
+	}{}
+	if err = json.Unmarshal(resp.Data, &errResp); err == nil && errResp.Error != nil {
+		errResp.Error.Method = method
+		return errResp.Error
+	}
+
+	// Unmarshal the result
+	if result == nil {
+		return nil
+	}
+	return json.Unmarshal(resp.Data, result)
+}
+
+// subscribe will add the listener to the subscription and subscribe (if it's a new subscription)
+//
+// Subscriptions with params are sent again whenever the listeners change (the params are combined)
+func (s *StreamClient) subscribe(ctx context.Context, name string, sub *streamSubscription,
+	listener *streamListener) error {
+
+	s.subscribeMu.Lock()
+	defer s.subscribeMu.Unlock()
+
+	// Already subscribed (add the listener)
+	s.mu.Lock()
+	if existing, ok := s.subscriptions[name]; ok {
+		existing.listeners[listener] = struct{}{}
+		s.mu.Unlock()
+		if existing.params == nil {
+			return nil
+		}
+		if err := s.sendSubscription(ctx, name, existing); err != nil {
+			s.mu.Lock()
+			delete(existing.listeners, listener)
+			s.mu.Unlock()
+			return err
+		}
+		return nil
+	}
+
+	// Save before subscribing (a reconnect will re-subscribe)
+	sub.listeners = map[*streamListener]struct{}{listener: {}}
+	s.subscriptions[name] = sub
+	s.mu.Unlock()
+
+	// Subscribe and wait for the confirmation
+	if err := s.sendSubscription(ctx, name, sub); err != nil {
+		s.mu.Lock()
+		delete(s.subscriptions, name)
+		s.mu.Unlock()
+		return err
+	}
+	return nil
+}
+
+// updateSubscription will send the subscription again (after the params of a listener changed)
+func (s *StreamClient) updateSubscription(ctx context.Context, name string) error {
+	s.subscribeMu.Lock()
+	defer s.subscribeMu.Unlock()
+
+	// The subscription is removed once the stream is closed
+	s.mu.Lock()
+	sub, ok := s.subscriptions[name]
+	closed := s.closed
+	s.mu.Unlock()
+	if closed || !ok {
+		return ErrStreamClosed
+	}
+	return s.sendSubscription(ctx, name, sub)
+}
+
+// sendSubscription will send the subscription request (the caller must hold the subscribe lock)
+//
+// Every request uses a new ID (IE: newBlock:7), Blockbook uses the latest ID for the notifications
+func (s *StreamClient) sendSubscription(ctx context.Context, name string, sub *streamSubscription) error {
+	var params interface{}
+	s.mu.Lock()
+	if sub.params != nil {
+		params = sub.params()
+	}
+	s.mu.Unlock()
+	return s.requestWithID(ctx, s.subscriptionID(name), sub.method, params, nil)
+}
+
+// removeListener will remove the listener and unsubscribe if it was the last listener
+func (s *StreamClient) removeListener(name string, listener *streamListener) {
+	s.subscribeMu.Lock()
+	defer s.subscribeMu.Unlock()
+
+	s.mu.Lock()
+	sub, ok := s.subscriptions[name]
+	if !ok {
+		s.mu.Unlock()
+		return
+	}
+	delete(sub.listeners, listener)
+	remaining := len(sub.listeners) > 0
+	if !remaining {
+		delete(s.subscriptions, name)
+	}
+	connected := s.conn != nil && !s.closed
+	s.mu.Unlock()
+
+	// Nothing to send (no changes or not connected)
+	if !connected || (remaining && sub.params == nil) {
+		return
+	}
+
+	// Update or unsubscribe (best effort, the listener is already removed)
+	ctx, cancel := context.WithTimeout(context.Background(), s.options.DialTimeout)
+	defer cancel()
+	if remaining {
+		_ = s.sendSubscription(ctx, name, sub)
+		return
+	}
+	_ = s.requestWithID(ctx, s.subscriptionID(name), sub.unsubscribe, nil, nil)
+}
+
+// subscriptionID will return a new request ID for the subscription (IE: newBlock:7)
+func (s *StreamClient) subscriptionID(name string) string {
+	return name + streamIDSeparator + strconv.FormatUint(s.nextID(), 10)
+}
+
+// listen will deliver the subscription notifications to the handler until the listener context is done
+func (s *StreamClient) listen(name string, listener *streamListener, handler func(data json.RawMessage) bool) {
+	defer s.removeListener(name, listener)
+	for {
+		select {
+		case data := <-listener.in:
+			if !handler(data) {
+				return
+			}
+		case <-listener.ctx.Done():
+			return
+		case <-s.done:
+			return
+		}
+	}
+}
+
+// newListener will create a new listener for the given context
+func (s *StreamClient) newListener(ctx context.Context) *streamListener {
+	size := s.options.BufferSize
+	if size < 1 {
+		size = 1
+	}
+	return &streamListener{ctx: ctx, in: make(chan json.RawMessage, size)}
+}
+
+// nextID will return the next (unique) request ID
+func (s *StreamClient) nextID() uint64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.lastID++
+	return s.lastID
+}
+
+// connect will open the connection (if not already connected)
+func (s *StreamClient) connect(ctx context.Context) (*streamConn, error) {
+	s.connectMu.Lock()
+	defer s.connectMu.Unlock()
+
+	// Already connected?
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		return nil, ErrStreamClosed
+	} else if s.conn != nil {
+		conn := s.conn
+		s.mu.Unlock()
+		return conn, nil
+	}
+	s.mu.Unlock()
+
+	// Are we using a supported blockchain?
+	if !isBlockchainSupported(streamBlockchains, s.chain) {
+		return nil, ErrUnsupportedBlockchain
+	}
+
+	// Set the headers (user agent is in case they block default Go user agents)
+	header := http.Header{}
+	header.Set("User-Agent", s.client.options.userAgent)
+	if len(s.client.options.apiKey) > 0 {
+		header.Set(apiHeaderKey, s.client.options.apiKey)
+	}
+
+	// Dial the WebSocket
+	dialer := &websocket.Dialer{
+		HandshakeTimeout: s.options.DialTimeout,
+		Proxy:            http.ProxyFromEnvironment,
+	}
+	ws, resp, err := dialer.DialContext(ctx, s.URL(), header)
+	if resp != nil && resp.Body != nil {
+		_ = resp.Body.Close()
+	}
+	if err != nil {
+		if resp != nil {
+			return nil, newAPIError(&RequestResponse{
+				Method:     http.MethodGet,
+				StatusCode: resp.StatusCode,
+				URL:        s.URL(),
+			}, err.Error())
+		}
+		return nil, err
+	}
+
+	// Save the connection (unless closed while dialing)
+	conn := &streamConn{done: make(chan struct{}), ws: ws}
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		_ = ws.Close()
+		return nil, ErrStreamClosed
+	}
+	s.conn = conn
+	s.mu.Unlock()
+
+	// Keep the connection alive (missed pongs will drop the connection)
+	if s.options.PingInterval > 0 {
+		_ = ws.SetReadDeadline(time.Now().Add(2 * s.options.PingInterval))
+		ws.SetPongHandler(func(string) error {
+			return ws.SetReadDeadline(time.Now().Add(2 * s.options.PingInterval))
+		})
+		go s.pingLoop(conn)
+	}
+
+	go s.readLoop(conn)
+	return conn, nil
+}
+
+// write will write the request to the connection
+func (s *StreamClient) write(conn *streamConn, req *streamRequest) error {
+	conn.writeMu.Lock()
+	defer conn.writeMu.Unlock()
+	_ = conn.ws.SetWriteDeadline(time.Now().Add(s.options.DialTimeout))
+	return conn.ws.WriteJSON(req)
+}
+
+// readLoop will read all messages from the connection until it's dropped
+func (s *StreamClient) readLoop(conn *streamConn) {
+	defer s.disconnected(conn)
+	for {
+		resp := new(streamResponse)
+		if err := conn.ws.ReadJSON(resp); err != nil {
+			var syntaxErr *json.SyntaxError
+			if errors.As(err, &syntaxErr) {
+				continue // Skip invalid messages
+			}
+			return
+		}
+		if s.options.PingInterval > 0 {
+			_ = conn.ws.SetReadDeadline(time.Now().Add(2 * s.options.PingInterval))
+		}
+		s.dispatch(resp)
+	}
+}
+
+// pingLoop will ping the server until the connection is dropped
+func (s *StreamClient) pingLoop(conn *streamConn) {
+	ticker := time.NewTicker(s.options.PingInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			conn.writeMu.Lock()
+			err := conn.ws.WriteControl(websocket.PingMessage, nil, time.Now().Add(s.options.DialTimeout))
+			conn.writeMu.Unlock()
+			if err != nil {
+				_ = conn.ws.Close()
+				return
+			}
+		case <-conn.done:
+			return
+		}
+	}
+}
+
+// dispatch will deliver the response to the pending request or the subscription listeners
+func (s *StreamClient) dispatch(resp *streamResponse) {
+	s.mu.Lock()
+
+	// Response to a request
+	if respCh, ok := s.pending[resp.ID]; ok {
+		delete(s.pending, resp.ID)
+		s.mu.Unlock()
+		respCh <- resp
+		return
+	}
+
+	// Subscription notification (IE: newBlock:7 is for the newBlock subscription)
+	name, _, _ := strings.Cut(resp.ID, streamIDSeparator)
+	sub, ok := s.subscriptions[name]
+	if !ok {
+		s.mu.Unlock()
+		return
+	}
+	listeners := make([]*streamListener, 0, len(sub.listeners))
+	for listener := range sub.listeners {
+		listeners = append(listeners, listener)
+	}
+	s.mu.Unlock()
+
+	// Deliver to all listeners (never blocks the read loop)
+	for _, listener := range listeners {
+		listener.deliver(resp.Data)
+	}
+}
+
+// deliver will queue the notification without blocking (drops the oldest notification if the buffer is full)
+func (l *streamListener) deliver(data json.RawMessage) {
+	for {
+		select {
+		case l.in <- data:
+			return
+		default:
+		}
+		select {
+		case <-l.in:
+		default:
+		}
+	}
+}
+
+// disconnected will clean up the dropped connection and reconnect if there are subscriptions
+func (s *StreamClient) disconnected(conn *streamConn) {
+	close(conn.done)
+	_ = conn.ws.Close()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.conn != conn {
+		return
+	}
+	s.conn = nil
+	s.failPending()
+	if !s.closed && len(s.subscriptions) > 0 {
+		go s.reconnect()
+	}
+}
+
+// reconnect will re-open the connection (with back-off) and re-subscribe all subscriptions
+func (s *StreamClient) reconnect() {
+	delay := s.options.BackOffInitialTimeout
+	for {
+		select {
+		case <-time.After(delay):
+		case <-s.done:
+			return
+		}
+
+		// The dial and every re-subscribe get their own timeout
+		ctx, cancel := context.WithTimeout(context.Background(), s.options.DialTimeout)
+		_, err := s.connect(ctx)
+		cancel()
+		if err == nil {
+			err = s.resubscribe()
+		}
+		if err == nil || errors.Is(err, ErrStreamClosed) {
+			return
+		} else if errors.Is(err, ErrStreamDisconnected) {
+			return // Dropped again, the next reconnect has already started
+		}
+
+		// Increase the back-off
+		if delay = time.Duration(float64(delay) * s.options.BackOffExponentFactor); delay > s.options.BackOffMaxTimeout {
+			delay = s.options.BackOffMaxTimeout
+		}
+	}
+}
+
+// resubscribe will send all active subscriptions again (after a reconnect)
+func (s *StreamClient) resubscribe() error {
+	s.subscribeMu.Lock()
+	defer s.subscribeMu.Unlock()
+
+	s.mu.Lock()
+	subs := make(map[string]*streamSubscription, len(s.subscriptions))
+	for name, sub := range s.subscriptions {
+		subs[name] = sub
+	}
+	s.mu.Unlock()
+
+	for name, sub := range subs {
+		ctx, cancel := context.WithTimeout(context.Background(), s.options.DialTimeout)
+		err := s.sendSubscription(ctx, name, sub)
+		cancel()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// failPending will fail all pending requests (the caller must hold the lock)
+func (s *StreamClient) failPending() {
+	for id, respCh := range s.pending {
+		respCh <- nil
+		delete(s.pending, id)
+	}
+}
@@ -0,0 +1,166 @@
+package nownodes
+
+import (
+	"context"
+	"strconv"
+)
+
+// StreamInfo is the Blockbook information returned to the GetInfo (WebSocket) request
+type StreamInfo struct {
+	Backend    *StreamBackendInfo `json:"backend"`
+	BestHash   string             `json:"bestHash"`
+	BestHeight uint64             `json:"bestHeight"`
+	Block0Hash string             `json:"block0Hash"` // Genesis block hash
+	Decimals   uint64             `json:"decimals"`
+	Name       string             `json:"name"`     // IE: Bitcoin
+	Shortcut   string             `json:"shortcut"` // IE: BTC
+	Testnet    bool               `json:"testnet"`
+	Version    string             `json:"version"` // Blockbook version
+}
+
+// StreamBackendInfo is the node behind Blockbook
+type StreamBackendInfo struct {
+	Subversion string `json:"subversion"`
+	Version    string `json:"version"`
+}
+
+// streamAccountParams are the getAccountInfo params
+type streamAccountParams struct {
+	ContractFilter string      `json:"contractFilter,omitempty"`
+	Descriptor     string      `json:"descriptor"` // Address or xpub
+	Details        DetailLevel `json:"details"`
+	From           uint64      `json:"from,omitempty"`
+	Page           uint64      `json:"page,omitempty"`
+	PageSize       uint64      `json:"pageSize"`
+	To             uint64      `json:"to,omitempty"`
+}
+
+// streamFeeResult is a single estimateFee result
+type streamFeeResult struct {
+	FeePerUnit string `json:"feePerUnit"` // Satoshis per kilobyte
+}
+
+// GetInfo will get the Blockbook information (name, best block, backend version)
+func (s *StreamClient) GetInfo(ctx context.Context) (*StreamInfo, error) {
+	info := new(StreamInfo)
+	if err := s.request(ctx, streamMethodGetInfo, nil, info); err != nil {
+		return nil, err
+	}
+	return info, nil
+}
+
+// GetAddress will get address information by a given address (same result as GetAddressWithOptions)
+//
+// param: options is optional (nil will use the same defaults as the HTTP request)
+func (s *StreamClient) GetAddress(ctx context.Context, address string,
+	options *AddressOptions) (*AddressInfo, error) {
+
+	// Validate the input
+	if !isBlockchainSupported(getAddressBlockchains, s.chain) {
+		return nil, ErrUnsupportedBlockchain
+	} else if !s.chain.ValidateAddress(address) {
+		return nil, ErrInvalidAddress
+	}
+
+	// Fire the WebSocket request
+	info := new(AddressInfo)
+	if err := s.request(ctx, streamMethodGetAccountInfo, options.streamParams(address), info); err != nil {
+		return nil, err
+	}
+	return info, nil
+}
+
+// GetTransaction will get transaction information by a given TxID (same result as GetTransaction)
+func (s *StreamClient) GetTransaction(ctx context.Context, txID string) (*TransactionInfo, error) {
+
+	// Validate the input
+	if !isBlockchainSupported(getTransactionBlockchains, s.chain) {
+		return nil, ErrUnsupportedBlockchain
+	} else if !s.chain.ValidateTxID(txID) {
+		return nil, ErrInvalidTxID
+	}
+
+	// Fire the WebSocket request
+	info := new(TransactionInfo)
+	if err := s.request(ctx, streamMethodGetTransaction, map[string]string{"txid": txID}, info); err != nil {
+		return nil, err
+	}
+	return info, nil
+}
+
+// SendTransaction will broadcast the given tx hex payload (there is no size limit, unlike the HTTP request)
+func (s *StreamClient) SendTransaction(ctx context.Context, txHex string) (*BroadcastResult, error) {
+
+	// Validate the input
+	if !isBlockchainSupported(sendTransactionBlockchains, s.chain) {
+		return nil, ErrUnsupportedBlockchain
+	} else if !s.chain.ValidateTxHex(txHex) {
+		return nil, ErrInvalidTxHex
+	}
+
+	// Fire the WebSocket request
+	result := new(BroadcastResult)
+	if err := s.request(ctx, streamMethodSendTransaction, map[string]string{"hex": txHex}, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// EstimateFee will estimate the fee rate needed for a transaction to confirm within the given blocks
+//
+// There is no Node API fall back (ErrFeeEstimateUnavailable is returned if Blockbook has no estimate)
+func (s *StreamClient) EstimateFee(ctx context.Context, blocks uint64) (*FeeEstimate, error) {
+
+	// Validate the input
+	if !isBlockchainSupported(estimateFeeBlockchains, s.chain) {
+		return nil, ErrUnsupportedBlockchain
+	} else if blocks == 0 {
+		return nil, ErrInvalidConfirmationTarget
+	}
+
+	// Fire the WebSocket request
+	var results []*streamFeeResult
+	if err := s.request(
+		ctx, streamMethodEstimateFee, map[string][]uint64{"blocks": {blocks}}, &results,
+	); err != nil {
+		return nil, err
+	} else if len(results) == 0 {
+		return nil, ErrFeeEstimateUnavailable
+	}
+
+	// Blockbook returns -1 (or nothing) if there is no estimate
+	rate, _ := strconv.ParseFloat(results[0].FeePerUnit, 64)
+	if rate <= 0 {
+		return nil, ErrFeeEstimateUnavailable
+	}
+	return &FeeEstimate{
+		Blocks:          blocks,
+		SatoshisPerByte: rate / bytesPerKilobyte,
+		Source:          FeeSourceBlockBook,
+	}, nil
+}
+
+// streamParams will return the getAccountInfo params for the options (using the HTTP defaults)
+//
+// The WebSocket defaults are different (basic details and 25 per page)
+func (o *AddressOptions) streamParams(address string) *streamAccountParams {
+	params := &streamAccountParams{
+		Descriptor: address,
+		Details:    DetailsTxIDs,
+		PageSize:   defaultAddressPageSize,
+	}
+	if o == nil {
+		return params
+	}
+	params.ContractFilter = o.Contract
+	params.From = o.From
+	params.Page = o.Page
+	params.To = o.To
+	if len(o.Details) > 0 {
+		params.Details = o.Details
+	}
+	if o.PageSize > 0 {
+		params.PageSize = o.PageSize
+	}
+	return params
+}
@@ -0,0 +1,346 @@
+package nownodes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testStreamTx is the getTransaction result (raw JSON)
+const testStreamTx = `{"txid":"` + testBitcoinTxID + `","version":1,"vin":[],"vout":[],"blockHash":"` + testBlockHash + `","blockHeight":723772,"confirmations":622,"blockTime":1643111792,"value":"450","valueIn":"546","fees":"96"}`
+
+// newTestRequestsBlockbook will start a local Blockbook answering all the request/response methods
+func newTestRequestsBlockbook() *testBlockbook {
+	b := newTestBlockbook()
+	b.handle(streamMethodGetInfo, func(json.RawMessage) string {
+		return `{"name":"Bitcoin","shortcut":"BTC","decimals":8,"version":"0.3.6","bestHeight":723772,"bestHash":"` + testBlockHash + `","block0Hash":"000000000019d6689c085ae165831e934ff763ae46a2a6c172b3f1b60a8ce26f","testnet":false,"backend":{"version":"220000","subversion":"/Satoshi:22.0.0/"}}`
+	})
+	b.handle(streamMethodGetAccountInfo, func(params json.RawMessage) string {
+		var req streamAccountParams
+		_ = json.Unmarshal(params, &req)
+		return `{"page":1,"totalPages":1,"itemsOnPage":` + fmt.Sprint(req.PageSize) + `,"address":"` + req.Descriptor + `","balance":"0","totalReceived":"1000","totalSent":"1000","unconfirmedBalance":"0","unconfirmedTxs":0,"txs":1,"txids":["` + testBitcoinTxID + `"]}`
+	})
+	b.handle(streamMethodGetTransaction, func(params json.RawMessage) string {
+		var req struct {
+			TxID string `json:"txid"`
+		}
+		if _ = json.Unmarshal(params, &req); req.TxID != testBitcoinTxID {
+			return `{"error":{"message":"Transaction '` + req.TxID + `' not found"}}`
+		}
+		return testStreamTx
+	})
+	b.handle(streamMethodSendTransaction, func(json.RawMessage) string {
+		return `{"result":"` + testBTCTxHexID + `"}`
+	})
+	b.handle(streamMethodEstimateFee, func(json.RawMessage) string {
+		return `[{"feePerUnit":"12000"}]`
+	})
+	return b
+}
+
+func TestStreamClient_GetInfo(t *testing.T) {
+	t.Parallel()
+
+	b := newTestRequestsBlockbook()
+	defer b.close()
+	s := NewClient(WithAPIKey(testKey)).NewStreamClient(BTC, b.options())
+	defer func() {
+		_ = s.Close()
+	}()
+
+	info, err := s.GetInfo(context.Background())
+	require.NoError(t, err)
+	require.NotNil(t, info)
+	assert.Equal(t, "BTC", info.Shortcut)
+	assert.Equal(t, uint64(testBlockHeight), info.BestHeight)
+	assert.Equal(t, testBlockHash, info.BestHash)
+	require.NotNil(t, info.Backend)
+	assert.Equal(t, "/Satoshi:22.0.0/", info.Backend.Subversion)
+}
+
+func TestStreamClient_GetAddress(t *testing.T) {
+	t.Parallel()
+
+	t.Run("http defaults", func(t *testing.T) {
+		b := newTestRequestsBlockbook()
+		defer b.close()
+		s := NewClient(WithAPIKey(testKey)).NewStreamClient(BTC, b.options())
+		defer func() {
+			_ = s.Close()
+		}()
+
+		info, err := s.GetAddress(context.Background(), testBTCAddress, nil)
+		require.NoError(t, err)
+		assert.Equal(t, testBTCAddress, info.Address)
+		assert.Equal(t, uint64(defaultAddressPageSize), info.ItemsOnPage)
+		assert.Equal(t, []string{testBitcoinTxID}, info.TxIDs)
+
+		req := b.waitForRequest(t, streamMethodGetAccountInfo)
+		var params streamAccountParams
+		require.NoError(t, json.Unmarshal(req.Params.(json.RawMessage), &params))
+		assert.Equal(t, DetailsTxIDs, params.Details)
+	})
+
+	t.Run("options", func(t *testing.T) {
+		b := newTestRequestsBlockbook()
+		defer b.close()
+		s := NewClient(WithAPIKey(testKey)).NewStreamClient(BTC, b.options())
+		defer func() {
+			_ = s.Close()
+		}()
+
+		info, err := s.GetAddress(context.Background(), testBTCAddress, &AddressOptions{
+			Details: DetailsBasic, From: 700000, Page: 2, PageSize: 10, To: 723772,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, uint64(10), info.ItemsOnPage)
+
+		req := b.waitForRequest(t, streamMethodGetAccountInfo)
+		var params streamAccountParams
+		require.NoError(t, json.Unmarshal(req.Params.(json.RawMessage), &params))
+		assert.Equal(t, streamAccountParams{
+			Descriptor: testBTCAddress, Details: DetailsBasic, From: 700000, Page: 2, PageSize: 10, To: 723772,
+		}, params)
+	})
+
+	t.Run("invalid address", func(t *testing.T) {
+		s := NewClient(WithAPIKey(testKey)).NewStreamClient(BTC, nil)
+		info, err := s.GetAddress(context.Background(), "invalid", nil)
+		require.Nil(t, info)
+		assert.ErrorIs(t, err, ErrInvalidAddress)
+	})
+
+	t.Run("unsupported chain", func(t *testing.T) {
+		s := NewClient(WithAPIKey(testKey)).NewStreamClient(ETH, nil)
+		info, err := s.GetAddress(context.Background(), testETHAddress, nil)
+		require.Nil(t, info)
+		assert.ErrorIs(t, err, ErrUnsupportedBlockchain)
+	})
+}
+
+func TestStreamClient_GetTransaction(t *testing.T) {
+	t.Parallel()
+
+	b := newTestRequestsBlockbook()
+	defer b.close()
+	s := NewClient(WithAPIKey(testKey)).NewStreamClient(BTC, b.options())
+	defer func() {
+		_ = s.Close()
+	}()
+
+	t.Run("valid tx", func(t *testing.T) {
+		info, err := s.GetTransaction(context.Background(), testBitcoinTxID)
+		require.NoError(t, err)
+		assert.Equal(t, testBitcoinTxID, info.TxID)
+		assert.Equal(t, int64(testBlockHeight), info.BlockHeight)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		info, err := s.GetTransaction(context.Background(), testBTCTxHexID)
+		require.Nil(t, info)
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("invalid tx id", func(t *testing.T) {
+		info, err := s.GetTransaction(context.Background(), "invalid")
+		require.Nil(t, info)
+		assert.ErrorIs(t, err, ErrInvalidTxID)
+	})
+}
+
+func TestStreamClient_SendTransaction(t *testing.T) {
+	t.Parallel()
+
+	b := newTestRequestsBlockbook()
+	defer b.close()
+	s := NewClient(WithAPIKey(testKey)).NewStreamClient(BTC, b.options())
+	defer func() {
+		_ = s.Close()
+	}()
+
+	t.Run("valid broadcast", func(t *testing.T) {
+		result, err := s.SendTransaction(context.Background(), testBTCTxHex)
+		require.NoError(t, err)
+		assert.Equal(t, testBTCTxHexID, result.Result)
+
+		req := b.waitForRequest(t, streamMethodSendTransaction)
+		assert.JSONEq(t, `{"hex":"`+testBTCTxHex+`"}`, string(req.Params.(json.RawMessage)))
+	})
+
+	t.Run("invalid tx hex", func(t *testing.T) {
+		result, err := s.SendTransaction(context.Background(), "")
+		require.Nil(t, result)
+		assert.ErrorIs(t, err, ErrInvalidTxHex)
+	})
+}
+
+func TestStreamClient_EstimateFee(t *testing.T) {
+	t.Parallel()
+
+	t.Run("valid estimate", func(t *testing.T) {
+		b := newTestRequestsBlockbook()
+		defer b.close()
+		s := NewClient(WithAPIKey(testKey)).NewStreamClient(BTC, b.options())
+		defer func() {
+			_ = s.Close()
+		}()
+
+		estimate, err := s.EstimateFee(context.Background(), 2)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(2), estimate.Blocks)
+		assert.Equal(t, 12.0, estimate.SatoshisPerByte)
+		assert.Equal(t, FeeSourceBlockBook, estimate.Source)
+
+		req := b.waitForRequest(t, streamMethodEstimateFee)
+		assert.JSONEq(t, `{"blocks":[2]}`, string(req.Params.(json.RawMessage)))
+	})
+
+	t.Run("no estimate", func(t *testing.T) {
+		b := newTestRequestsBlockbook()
+		defer b.close()
+		b.handle(streamMethodEstimateFee, func(json.RawMessage) string {
+			return `[{"feePerUnit":"-1"}]`
+		})
+		s := NewClient(WithAPIKey(testKey)).NewStreamClient(BTC, b.options())
+		defer func() {
+			_ = s.Close()
+		}()
+
+		estimate, err := s.EstimateFee(context.Background(), 2)
+		require.Nil(t, estimate)
+		assert.ErrorIs(t, err, ErrFeeEstimateUnavailable)
+	})
+
+	t.Run("invalid target", func(t *testing.T) {
+		s := NewClient(WithAPIKey(testKey)).NewStreamClient(BTC, nil)
+		estimate, err := s.EstimateFee(context.Background(), 0)
+		require.Nil(t, estimate)
+		assert.ErrorIs(t, err, ErrInvalidConfirmationTarget)
+	})
+}
+
+func TestClient_StreamTransport(t *testing.T) {
+	t.Parallel()
+
+	t.Run("single connection", func(t *testing.T) {
+		b := newTestRequestsBlockbook()
+		defer b.close()
+		c := NewClient(WithAPIKey(testKey), WithHTTPClient(&errorDoReqErr{}), WithStreamTransport(b.options()))
+		defer func() {
+			_ = c.Close()
+		}()
+		ctx := context.Background()
+
+		address, err := c.GetAddress(ctx, BTC, testBTCAddress)
+		require.NoError(t, err)
+		assert.Equal(t, testBTCAddress, address.Address)
+
+		tx, err := c.GetTransaction(ctx, BTC, testBitcoinTxID)
+		require.NoError(t, err)
+		assert.Equal(t, testBitcoinTxID, tx.TxID)
+
+		result, err := c.SendTransaction(ctx, BTC, testBTCTxHex)
+		require.NoError(t, err)
+		assert.Equal(t, testBTCTxHexID, result.Result)
+
+		estimate, err := c.EstimateFee(ctx, BTC, 2)
+		require.NoError(t, err)
+		assert.Equal(t, FeeSourceBlockBook, estimate.Source)
+
+		// Concurrent requests are multiplexed on the same connection
+		var wg sync.WaitGroup
+		for i := 0; i < 20; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				tx, txErr := c.GetTransaction(ctx, BTC, testBitcoinTxID)
+				assert.NoError(t, txErr)
+				assert.NotNil(t, tx)
+			}()
+		}
+		wg.Wait()
+		assert.Equal(t, 1, b.connections())
+	})
+
+	t.Run("close and reconnect", func(t *testing.T) {
+		b := newTestRequestsBlockbook()
+		defer b.close()
+		c := NewClient(WithAPIKey(testKey), WithStreamTransport(b.options()))
+
+		_, err := c.GetTransaction(context.Background(), BTC, testBitcoinTxID)
+		require.NoError(t, err)
+		require.NoError(t, c.Close())
+
+		_, err = c.GetTransaction(context.Background(), BTC, testBitcoinTxID)
+		require.NoError(t, err)
+		assert.Equal(t, 2, b.connections())
+		require.NoError(t, c.Close())
+	})
+
+	t.Run("estimate fee falls back to the node api", func(t *testing.T) {
+		b := newTestRequestsBlockbook()
+		defer b.close()
+		b.handle(streamMethodEstimateFee, func(json.RawMessage) string {
+			return `[{"feePerUnit":"-1"}]`
+		})
+		c := NewClient(WithAPIKey(testKey), WithHTTPClient(&nodeMethodResponse{results: map[string]string{
+			nodeMethodEstimateSmartFee: `{"feerate":0.0001,"blocks":2}`,
+		}}), WithStreamTransport(b.options()))
+		defer func() {
+			_ = c.Close()
+		}()
+
+		estimate, err := c.EstimateFee(context.Background(), BTC, 2)
+		require.NoError(t, err)
+		assert.Equal(t, FeeSourceNode, estimate.Source)
+		assert.Equal(t, 10.0, estimate.SatoshisPerByte)
+	})
+
+	t.Run("unsupported chain uses http", func(t *testing.T) {
+		b := newTestRequestsBlockbook()
+		defer b.close()
+		c := NewClient(WithAPIKey(testKey), WithHTTPClient(&validTxResponse{}), WithStreamTransport(b.options()))
+		info, err := c.GetTransaction(context.Background(), ETH, testETHTxID)
+		require.NoError(t, err)
+		assert.Equal(t, testETHTxID, info.TxID)
+		assert.Equal(t, 0, b.connections())
+		require.NoError(t, c.Close())
+	})
+}
+
+func ExampleWithStreamTransport() {
+	b := newTestRequestsBlockbook()
+	defer b.close()
+
+	c := NewClient(WithAPIKey(testKey), WithStreamTransport(b.options()))
+	defer func() {
+		_ = c.Close()
+	}()
+
+	tx, err := c.GetTransaction(context.Background(), BTC, testBitcoinTxID)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Printf("tx found: %s", tx.TxID)
+	// Output:tx found: 17961a51337369bf64e45e8410a7ce4cfb0c88b5d883d9e8a939dfdd0f7591fd
+}
+
+func BenchmarkStreamClient_GetTransaction(b *testing.B) {
+	blockbook := newTestRequestsBlockbook()
+	defer blockbook.close()
+
+	s := NewClient(WithAPIKey(testKey)).NewStreamClient(BTC, blockbook.options())
+	defer func() {
+		_ = s.Close()
+	}()
+	ctx := context.Background()
+	for i := 0; i < b.N; i++ {
+		_, _ = s.GetTransaction(ctx, testBitcoinTxID)
+	}
+}
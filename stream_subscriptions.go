@@ -0,0 +1,273 @@
+package nownodes
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strings"
+)
+
+// BlockEvent is the new block notification delivered to the SubscribeNewBlock channel
+type BlockEvent struct {
+	Hash   string `json:"hash"`
+	Height uint64 `json:"height"`
+}
+
+// AddressTxEvent is the transaction notification delivered to the AddressSubscription events channel
+type AddressTxEvent struct {
+	Address string           `json:"address"`
+	Tx      *TransactionInfo `json:"tx"`
+}
+
+// AddressSubscription is a subscription to the transactions of a (changeable) set of addresses
+//
+// The addresses can be added or removed at any time without reconnecting, every change sends all
+// addresses (of all subscriptions) again, which is limited by StreamOptions.MaxAddresses
+type AddressSubscription struct {
+	events   chan AddressTxEvent
+	listener *streamListener
+	stream   *StreamClient
+}
+
+// SubscribeNewBlock will deliver a BlockEvent for every new block until the context is done
+//
+// The channel is closed when the context is done or the stream is closed, blocks mined while
+// reconnecting (or dropped if the channel falls behind, see StreamOptions.BufferSize) are not
+// delivered (use the height to detect any gaps)
+func (s *StreamClient) SubscribeNewBlock(ctx context.Context) (<-chan BlockEvent, error) {
+	listener := s.newListener(ctx)
+	if err := s.subscribe(ctx, streamSubscriptionNewBlock, &streamSubscription{
+		method:      streamMethodSubscribeNewBlock,
+		unsubscribe: streamMethodUnsubscribeNewBlock,
+	}, listener); err != nil {
+		return nil, err
+	}
+
+	events := make(chan BlockEvent)
+	go func() {
+		defer close(events)
+		s.listen(streamSubscriptionNewBlock, listener, func(data json.RawMessage) bool {
+			var event BlockEvent
+			if err := json.Unmarshal(data, &event); err != nil || len(event.Hash) == 0 {
+				return true // Skip invalid notifications
+			}
+			select {
+			case events <- event:
+				return true
+			case <-ctx.Done():
+				return false
+			case <-s.done:
+				return false
+			}
+		})
+	}()
+	return events, nil
+}
+
+// SubscribeAddresses will deliver an AddressTxEvent for every transaction (mempool and confirmed) of the
+// given addresses until the context is done
+//
+// ErrTooManyAddresses is returned if all address subscriptions would exceed StreamOptions.MaxAddresses
+// The events channel is closed when the context is done or the stream is closed, transactions received
+// while reconnecting (or dropped if the channel falls behind, see StreamOptions.BufferSize) are not
+// delivered (use GetAddress to catch up)
+func (s *StreamClient) SubscribeAddresses(ctx context.Context, addresses []string) (*AddressSubscription, error) {
+
+	// Validate the input
+	for _, address := range addresses {
+		if !s.chain.ValidateAddress(address) {
+			return nil, ErrInvalidAddress
+		}
+	}
+
+	listener := s.newListener(ctx)
+	listener.addresses = make(map[string]struct{}, len(addresses))
+	for _, address := range addresses {
+		listener.addresses[address] = struct{}{}
+	}
+	s.mu.RLock()
+	tooMany := s.tooManyAddresses(listener)
+	s.mu.RUnlock()
+	if tooMany {
+		return nil, ErrTooManyAddresses
+	}
+	if err := s.subscribe(ctx, streamSubscriptionAddresses, &streamSubscription{
+		method:      streamMethodSubscribeAddresses,
+		params:      s.addressParams,
+		unsubscribe: streamMethodUnsubscribeAddresses,
+	}, listener); err != nil {
+		return nil, err
+	}
+
+	sub := &AddressSubscription{
+		events:   make(chan AddressTxEvent),
+		listener: listener,
+		stream:   s,
+	}
+	go func() {
+		defer close(sub.events)
+		s.listen(streamSubscriptionAddresses, listener, func(data json.RawMessage) bool {
+			var event AddressTxEvent
+			if err := json.Unmarshal(data, &event); err != nil || event.Tx == nil || !sub.watching(event.Address) {
+				return true // Skip invalid notifications (or addresses of other subscriptions)
+			}
+			select {
+			case sub.events <- event:
+				return true
+			case <-ctx.Done():
+				return false
+			case <-s.done:
+				return false
+			}
+		})
+	}()
+	return sub, nil
+}
+
+// Events will return the channel of address transaction events
+func (a *AddressSubscription) Events() <-chan AddressTxEvent {
+	return a.events
+}
+
+// Addresses will return the (sorted) addresses of the subscription
+func (a *AddressSubscription) Addresses() []string {
+	a.stream.mu.RLock()
+	defer a.stream.mu.RUnlock()
+	addresses := make([]string, 0, len(a.listener.addresses))
+	for address := range a.listener.addresses {
+		addresses = append(addresses, address)
+	}
+	sort.Strings(addresses)
+	return addresses
+}
+
+// Add will add the addresses to the subscription (without reconnecting)
+//
+// The subscription is unchanged if the update fails (or ErrTooManyAddresses is returned)
+func (a *AddressSubscription) Add(ctx context.Context, addresses ...string) error {
+
+	// Validate the input
+	for _, address := range addresses {
+		if !a.stream.chain.ValidateAddress(address) {
+			return ErrInvalidAddress
+		}
+	}
+
+	return a.update(ctx, addresses, true)
+}
+
+// Remove will remove the addresses from the subscription (without reconnecting)
+//
+// The subscription is unchanged if the update fails
+func (a *AddressSubscription) Remove(ctx context.Context, addresses ...string) error {
+	return a.update(ctx, addresses, false)
+}
+
+// update will add or remove the addresses and send the subscription again (reverting on failure)
+func (a *AddressSubscription) update(ctx context.Context, addresses []string, add bool) error {
+
+	// The subscription has ended
+	if err := a.listener.ctx.Err(); err != nil {
+		return err
+	}
+
+	// Only keep track of the actual changes (to revert them)
+	var changed []string
+	a.stream.mu.Lock()
+	for _, address := range addresses {
+		if _, ok := a.listener.addresses[address]; ok != add {
+			changed = append(changed, address)
+			a.setAddress(address, add)
+		}
+	}
+	if add && a.stream.tooManyAddresses(nil) {
+		for _, address := range changed {
+			a.setAddress(address, false)
+		}
+		a.stream.mu.Unlock()
+		return ErrTooManyAddresses
+	}
+	a.stream.mu.Unlock()
+	if len(changed) == 0 {
+		return nil
+	}
+
+	if err := a.stream.updateSubscription(ctx, streamSubscriptionAddresses); err != nil {
+		a.stream.mu.Lock()
+		for _, address := range changed {
+			a.setAddress(address, !add)
+		}
+		a.stream.mu.Unlock()
+		return err
+	}
+	return nil
+}
+
+// setAddress will add or remove the address (the caller must hold the stream lock)
+func (a *AddressSubscription) setAddress(address string, add bool) {
+	if add {
+		a.listener.addresses[address] = struct{}{}
+	} else {
+		delete(a.listener.addresses, address)
+	}
+}
+
+// watching will return true if the address is in the subscription
+//
+// BCH addresses are matched with or without the "bitcoincash:" prefix
+func (a *AddressSubscription) watching(address string) bool {
+	a.stream.mu.RLock()
+	defer a.stream.mu.RUnlock()
+	if _, ok := a.listener.addresses[address]; ok {
+		return true
+	}
+	if a.stream.chain != BCH {
+		return false
+	}
+	if strings.HasPrefix(address, bitcoinCashPrefix) {
+		_, ok := a.listener.addresses[strings.TrimPrefix(address, bitcoinCashPrefix)]
+		return ok
+	}
+	_, ok := a.listener.addresses[bitcoinCashPrefix+address]
+	return ok
+}
+
+// addressParams will return the subscribeAddresses params (all addresses of all listeners)
+//
+// The caller must hold the stream lock
+func (s *StreamClient) addressParams() interface{} {
+	unique := s.subscribedAddresses(nil)
+	addresses := make([]string, 0, len(unique))
+	for address := range unique {
+		addresses = append(addresses, address)
+	}
+	sort.Strings(addresses)
+	return map[string][]string{"addresses": addresses}
+}
+
+// subscribedAddresses will return all addresses of all listeners (and the new listener, if any)
+//
+// The caller must hold the stream lock
+func (s *StreamClient) subscribedAddresses(newListener *streamListener) map[string]struct{} {
+	unique := make(map[string]struct{})
+	if newListener != nil {
+		for address := range newListener.addresses {
+			unique[address] = struct{}{}
+		}
+	}
+	if sub, ok := s.subscriptions[streamSubscriptionAddresses]; ok {
+		for listener := range sub.listeners {
+			for address := range listener.addresses {
+				unique[address] = struct{}{}
+			}
+		}
+	}
+	return unique
+}
+
+// tooManyAddresses will return true if all addresses (and the new listener, if any) exceed the max addresses
+//
+// The caller must hold the stream lock
+func (s *StreamClient) tooManyAddresses(newListener *streamListener) bool {
+	return s.options.MaxAddresses > 0 && len(s.subscribedAddresses(newListener)) > s.options.MaxAddresses
+}
@@ -0,0 +1,878 @@
+package nownodes
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testStreamTimeout is the max wait for any stream event in the tests
+const testStreamTimeout = 2 * time.Second
+
+// testBlockbook is a local Blockbook WebSocket server
+type testBlockbook struct {
+	conns      []*testBlockbookConn                           // Open connections
+	connects   int                                            // Number of connections (including reconnects)
+	handlers   map[string]func(params json.RawMessage) string // Result (raw JSON) by method
+	header     http.Header                                    // Headers of the last connection
+	ignorePing bool                                           // Never answer pings (missed pongs)
+	mu         sync.Mutex
+	requests   chan streamRequest // All requests received
+	server     *httptest.Server
+}
+
+// testBlockbookConn is a single connection to the local Blockbook
+type testBlockbookConn struct {
+	mu sync.Mutex
+	ws *websocket.Conn
+}
+
+// newTestBlockbook will start a local Blockbook WebSocket server (close when done)
+func newTestBlockbook() *testBlockbook {
+	b := &testBlockbook{
+		handlers: make(map[string]func(params json.RawMessage) string),
+		requests: make(chan streamRequest, 100),
+	}
+	upgrader := websocket.Upgrader{}
+	b.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if len(r.Header.Get(apiHeaderKey)) == 0 {
+			http.Error(w, `{"error":"missing api-key"}`, http.StatusUnauthorized)
+			return
+		}
+		ws, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		conn := &testBlockbookConn{ws: ws}
+		b.mu.Lock()
+		b.conns = append(b.conns, conn)
+		b.connects++
+		b.header = r.Header.Clone()
+		if b.ignorePing {
+			ws.SetPingHandler(func(string) error { return nil })
+		}
+		b.mu.Unlock()
+		go b.serve(conn)
+	}))
+	return b
+}
+
+// serve will answer all requests on the connection
+func (b *testBlockbook) serve(conn *testBlockbookConn) {
+	defer b.remove(conn)
+	for {
+		var req struct {
+			ID     string          `json:"id"`
+			Method string          `json:"method"`
+			Params json.RawMessage `json:"params"`
+		}
+		if err := conn.ws.ReadJSON(&req); err != nil {
+			return
+		}
+		select {
+		case b.requests <- streamRequest{ID: req.ID, Method: req.Method, Params: req.Params}:
+		default:
+		}
+
+		// Find the result
+		result := `{"error":{"message":"unknown method"}}`
+		switch {
+		case req.Method == streamMethodPing:
+			result = `{}`
+		case strings.HasPrefix(req.Method, "subscribe"):
+			result = `{"subscribed":true}`
+		case strings.HasPrefix(req.Method, "unsubscribe"):
+			result = `{"subscribed":false}`
+		default:
+			b.mu.Lock()
+			handler, ok := b.handlers[req.Method]
+			b.mu.Unlock()
+			if ok {
+				if result = handler(req.Params); len(result) == 0 {
+					_ = conn.ws.Close() // No result will drop the connection
+					return
+				}
+			}
+		}
+		conn.write(`{"id":"` + req.ID + `","data":` + result + `}`)
+	}
+}
+
+// write will write the raw message to the connection
+func (c *testBlockbookConn) write(message string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	_ = c.ws.WriteMessage(websocket.TextMessage, []byte(message))
+}
+
+// notify will send the subscription notification to all connections
+func (b *testBlockbook) notify(id, data string) {
+	b.mu.Lock()
+	conns := append([]*testBlockbookConn{}, b.conns...)
+	b.mu.Unlock()
+	for _, conn := range conns {
+		conn.write(`{"id":"` + id + `","data":` + data + `}`)
+	}
+}
+
+// drop will close all connections
+func (b *testBlockbook) drop() {
+	b.mu.Lock()
+	conns := append([]*testBlockbookConn{}, b.conns...)
+	b.mu.Unlock()
+	for _, conn := range conns {
+		_ = conn.ws.Close()
+	}
+}
+
+// remove will remove the connection from the open connections
+func (b *testBlockbook) remove(conn *testBlockbookConn) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for i, c := range b.conns {
+		if c == conn {
+			b.conns = append(b.conns[:i], b.conns[i+1:]...)
+			return
+		}
+	}
+}
+
+// close will stop the server
+func (b *testBlockbook) close() {
+	b.drop()
+	b.server.Close()
+}
+
+// connections will return the number of connections (including reconnects)
+func (b *testBlockbook) connections() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.connects
+}
+
+// handle will set the result (raw JSON) for the method
+func (b *testBlockbook) handle(method string, handler func(params json.RawMessage) string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.handlers[method] = handler
+}
+
+// options will return the stream options for the local Blockbook
+func (b *testBlockbook) options() *StreamOptions {
+	options := DefaultStreamOptions()
+	options.BackOffInitialTimeout = 10 * time.Millisecond
+	options.BackOffMaxTimeout = 50 * time.Millisecond
+	options.DialTimeout = testStreamTimeout
+	options.URL = "ws" + strings.TrimPrefix(b.server.URL, "http")
+	return options
+}
+
+// waitForRequest will wait for the method to be received by the local Blockbook
+func (b *testBlockbook) waitForRequest(t *testing.T, method string) streamRequest {
+	timeout := time.After(testStreamTimeout)
+	for {
+		select {
+		case req := <-b.requests:
+			if req.Method == method {
+				return req
+			}
+		case <-timeout:
+			require.FailNow(t, "request not received: "+method)
+			return streamRequest{}
+		}
+	}
+}
+
+// receiveBlock will wait for the next block event
+func receiveBlock(t *testing.T, events <-chan BlockEvent) (BlockEvent, bool) {
+	select {
+	case event, ok := <-events:
+		return event, ok
+	case <-time.After(testStreamTimeout):
+		require.FailNow(t, "block event not received")
+		return BlockEvent{}, false
+	}
+}
+
+// receiveAddressTx will wait for the next address transaction event
+func receiveAddressTx(t *testing.T, events <-chan AddressTxEvent) (AddressTxEvent, bool) {
+	select {
+	case event, ok := <-events:
+		return event, ok
+	case <-time.After(testStreamTimeout):
+		require.FailNow(t, "address tx event not received")
+		return AddressTxEvent{}, false
+	}
+}
+
+// addressTxNotification will return the subscribeAddresses notification (raw JSON)
+func addressTxNotification(address, txID string) string {
+	return `{"address":"` + address + `","tx":{"txid":"` + txID + `","vin":[],"vout":[],"blockHeight":-1,"confirmations":0,"blockTime":1643111792,"value":"450","valueIn":"546","fees":"96"}}`
+}
+
+// subscribedAddresses will return the addresses of the subscribeAddresses request
+func subscribedAddresses(t *testing.T, req streamRequest) []string {
+	var params struct {
+		Addresses []string `json:"addresses"`
+	}
+	raw, ok := req.Params.(json.RawMessage)
+	require.True(t, ok)
+	require.NoError(t, json.Unmarshal(raw, &params))
+	return params.Addresses
+}
+
+func TestClient_NewStreamClient(t *testing.T) {
+	t.Parallel()
+
+	t.Run("default options", func(t *testing.T) {
+		c := NewClient(WithAPIKey(testKey))
+		s := c.NewStreamClient(BTC, nil)
+		require.NotNil(t, s)
+		assert.Equal(t, DefaultStreamOptions(), s.options)
+		assert.Equal(t, "wss://"+BTC.BlockBookURL()+"/websocket", s.URL())
+		assert.False(t, s.IsConnected())
+		require.NoError(t, s.Close())
+		require.NoError(t, s.Close())
+	})
+
+	t.Run("custom url", func(t *testing.T) {
+		c := NewClient(WithAPIKey(testKey))
+		s := c.NewStreamClient(BTC, &StreamOptions{URL: "ws://localhost:9130/websocket"})
+		assert.Equal(t, "ws://localhost:9130/websocket", s.URL())
+	})
+}
+
+func TestStreamClient_Ping(t *testing.T) {
+	t.Parallel()
+
+	t.Run("valid ping", func(t *testing.T) {
+		b := newTestBlockbook()
+		defer b.close()
+		c := NewClient(WithAPIKey(testKey), WithUserAgent("test-agent"))
+		s := c.NewStreamClient(BTC, b.options())
+		defer func() {
+			_ = s.Close()
+		}()
+
+		require.NoError(t, s.Ping(context.Background()))
+		assert.True(t, s.IsConnected())
+		require.NoError(t, s.Ping(context.Background()))
+		assert.Equal(t, 1, b.connections())
+
+		b.mu.Lock()
+		assert.Equal(t, testKey, b.header.Get(apiHeaderKey))
+		assert.Equal(t, "test-agent", b.header.Get("User-Agent"))
+		b.mu.Unlock()
+	})
+
+	t.Run("unsupported chain", func(t *testing.T) {
+		b := newTestBlockbook()
+		defer b.close()
+		s := NewClient(WithAPIKey(testKey)).NewStreamClient(ETH, b.options())
+		err := s.Ping(context.Background())
+		require.Error(t, err)
+		assert.ErrorIs(t, err, ErrUnsupportedBlockchain)
+	})
+
+	t.Run("missing api key", func(t *testing.T) {
+		b := newTestBlockbook()
+		defer b.close()
+		s := NewClient().NewStreamClient(BTC, b.options())
+		err := s.Ping(context.Background())
+		require.Error(t, err)
+		assert.ErrorIs(t, err, ErrUnauthorized)
+
+		var apiErr *APIError
+		require.ErrorAs(t, err, &apiErr)
+		assert.Equal(t, http.StatusUnauthorized, apiErr.StatusCode)
+	})
+
+	t.Run("dial error", func(t *testing.T) {
+		s := NewClient(WithAPIKey(testKey)).NewStreamClient(BTC, &StreamOptions{URL: "ws://127.0.0.1:1/websocket", DialTimeout: time.Second})
+		require.Error(t, s.Ping(context.Background()))
+		assert.False(t, s.IsConnected())
+	})
+
+	t.Run("closed stream", func(t *testing.T) {
+		b := newTestBlockbook()
+		defer b.close()
+		s := NewClient(WithAPIKey(testKey)).NewStreamClient(BTC, b.options())
+		require.NoError(t, s.Ping(context.Background()))
+		require.NoError(t, s.Close())
+		assert.False(t, s.IsConnected())
+
+		err := s.Ping(context.Background())
+		assert.ErrorIs(t, err, ErrStreamClosed)
+	})
+
+	t.Run("context canceled", func(t *testing.T) {
+		b := newTestBlockbook()
+		defer b.close()
+		s := NewClient(WithAPIKey(testKey)).NewStreamClient(BTC, b.options())
+		defer func() {
+			_ = s.Close()
+		}()
+		require.NoError(t, s.Ping(context.Background()))
+
+		// Never answered
+		b.handle("slow", func(json.RawMessage) string {
+			time.Sleep(200 * time.Millisecond)
+			return `{}`
+		})
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		err := s.request(ctx, "slow", nil, nil)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+}
+
+func TestStreamClient_request(t *testing.T) {
+	t.Parallel()
+
+	t.Run("error response", func(t *testing.T) {
+		b := newTestBlockbook()
+		defer b.close()
+		b.handle("getTransaction", func(json.RawMessage) string {
+			return `{"error":{"message":"Transaction '` + testTxID(BTC) + `' not found"}}`
+		})
+		s := NewClient(WithAPIKey(testKey)).NewStreamClient(BTC, b.options())
+		defer func() {
+			_ = s.Close()
+		}()
+
+		err := s.request(context.Background(), "getTransaction", map[string]string{"txid": testTxID(BTC)}, nil)
+		require.Error(t, err)
+		assert.ErrorIs(t, err, ErrNotFound)
+		assert.NotErrorIs(t, err, ErrUnauthorized)
+
+		var streamErr *StreamError
+		require.ErrorAs(t, err, &streamErr)
+		assert.Equal(t, "getTransaction", streamErr.Method)
+		assert.Equal(t, "method [getTransaction] error [Transaction '"+testTxID(BTC)+"' not found]", streamErr.Error())
+	})
+
+	t.Run("disconnected while waiting", func(t *testing.T) {
+		b := newTestBlockbook()
+		defer b.close()
+		b.handle("drop", func(json.RawMessage) string {
+			return ""
+		})
+		s := NewClient(WithAPIKey(testKey)).NewStreamClient(BTC, b.options())
+		defer func() {
+			_ = s.Close()
+		}()
+
+		err := s.request(context.Background(), "drop", nil, nil)
+		assert.ErrorIs(t, err, ErrStreamDisconnected)
+
+		// Reconnects on the next request
+		require.NoError(t, s.Ping(context.Background()))
+		assert.Equal(t, 2, b.connections())
+	})
+
+	t.Run("invalid result", func(t *testing.T) {
+		b := newTestBlockbook()
+		defer b.close()
+		b.handle("getInfo", func(json.RawMessage) string {
+			return `"not an object"`
+		})
+		s := NewClient(WithAPIKey(testKey)).NewStreamClient(BTC, b.options())
+		defer func() {
+			_ = s.Close()
+		}()
+
+		var result struct {
+			Name string `json:"name"`
+		}
+		require.Error(t, s.request(context.Background(), "getInfo", nil, &result))
+	})
+}
+
+func TestStreamError_Is(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		message  string
+		sentinel error
+	}{
+		{"Missing api-key header", ErrUnauthorized},
+		{"Unknown API_key", ErrUnauthorized},
+		{"Rate limit exceeded", ErrRateLimited},
+		{"Transaction 'abc' not found", ErrNotFound},
+		{"Invalid address", nil},
+		{"Error while fetching the api-key", nil},
+	}
+	for _, test := range tests {
+		t.Run(test.message, func(t *testing.T) {
+			streamErr := &StreamError{Message: test.message, Method: "getInfo"}
+			apiErr := &APIError{Message: test.message, StatusCode: http.StatusBadRequest}
+			for _, sentinel := range []error{ErrNotFound, ErrRateLimited, ErrUnauthorized} {
+				if sentinel == test.sentinel {
+					assert.ErrorIs(t, streamErr, sentinel)
+				} else {
+					assert.NotErrorIs(t, streamErr, sentinel)
+				}
+
+				// Same as the HTTP error
+				assert.Equal(t, errors.Is(apiErr, sentinel), errors.Is(streamErr, sentinel))
+			}
+		})
+	}
+}
+
+func TestStreamClient_SubscribeNewBlock(t *testing.T) {
+	t.Parallel()
+
+	t.Run("new blocks", func(t *testing.T) {
+		b := newTestBlockbook()
+		defer b.close()
+		s := NewClient(WithAPIKey(testKey)).NewStreamClient(BTC, b.options())
+		defer func() {
+			_ = s.Close()
+		}()
+
+		events, err := s.SubscribeNewBlock(context.Background())
+		require.NoError(t, err)
+		b.waitForRequest(t, streamMethodSubscribeNewBlock)
+
+		b.notify(streamSubscriptionNewBlock, `{"height":723772,"hash":"`+testBlockHash+`"}`)
+		event, ok := receiveBlock(t, events)
+		require.True(t, ok)
+		assert.Equal(t, uint64(testBlockHeight), event.Height)
+		assert.Equal(t, testBlockHash, event.Hash)
+
+		// Invalid notifications are skipped
+		b.notify(streamSubscriptionNewBlock, `{"height":"invalid"}`)
+		b.notify(streamSubscriptionNewBlock, `{"height":723773,"hash":"`+testBlockHash+`"}`)
+		event, ok = receiveBlock(t, events)
+		require.True(t, ok)
+		assert.Equal(t, uint64(723773), event.Height)
+	})
+
+	t.Run("multiple subscribers", func(t *testing.T) {
+		b := newTestBlockbook()
+		defer b.close()
+		s := NewClient(WithAPIKey(testKey)).NewStreamClient(BTC, b.options())
+		defer func() {
+			_ = s.Close()
+		}()
+
+		ctx1, cancel1 := context.WithCancel(context.Background())
+		events1, err := s.SubscribeNewBlock(ctx1)
+		require.NoError(t, err)
+		ctx2, cancel2 := context.WithCancel(context.Background())
+		defer cancel2()
+		events2, err := s.SubscribeNewBlock(ctx2)
+		require.NoError(t, err)
+
+		b.notify(streamSubscriptionNewBlock, `{"height":723772,"hash":"`+testBlockHash+`"}`)
+		event, _ := receiveBlock(t, events1)
+		assert.Equal(t, uint64(testBlockHeight), event.Height)
+		event, _ = receiveBlock(t, events2)
+		assert.Equal(t, uint64(testBlockHeight), event.Height)
+
+		// First subscriber is done (still subscribed for the second)
+		cancel1()
+		_, ok := receiveBlock(t, events1)
+		assert.False(t, ok)
+
+		b.notify(streamSubscriptionNewBlock, `{"height":723773,"hash":"`+testBlockHash+`"}`)
+		event, _ = receiveBlock(t, events2)
+		assert.Equal(t, uint64(723773), event.Height)
+
+		// Last subscriber is done (unsubscribed)
+		cancel2()
+		_, ok = receiveBlock(t, events2)
+		assert.False(t, ok)
+		b.waitForRequest(t, streamMethodUnsubscribeNewBlock)
+	})
+
+	t.Run("slow subscriber", func(t *testing.T) {
+		b := newTestBlockbook()
+		defer b.close()
+		options := b.options()
+		options.BufferSize = 2
+		s := NewClient(WithAPIKey(testKey)).NewStreamClient(BTC, options)
+		defer func() {
+			_ = s.Close()
+		}()
+
+		events, err := s.SubscribeNewBlock(context.Background())
+		require.NoError(t, err)
+		for height := 1; height <= 10; height++ {
+			b.notify(streamSubscriptionNewBlock, fmt.Sprintf(`{"height":%d,"hash":"%s"}`, height, testBlockHash))
+		}
+
+		// The connection is not blocked by the subscriber (notifications are read before the response)
+		require.NoError(t, s.Ping(context.Background()))
+
+		// The oldest notifications were dropped (the latest is always delivered)
+		received := 0
+		for {
+			event, ok := receiveBlock(t, events)
+			require.True(t, ok)
+			received++
+			if event.Height == 10 {
+				break
+			}
+		}
+		assert.LessOrEqual(t, received, options.BufferSize+1)
+	})
+
+	t.Run("reconnect", func(t *testing.T) {
+		b := newTestBlockbook()
+		defer b.close()
+		s := NewClient(WithAPIKey(testKey)).NewStreamClient(BTC, b.options())
+		defer func() {
+			_ = s.Close()
+		}()
+
+		events, err := s.SubscribeNewBlock(context.Background())
+		require.NoError(t, err)
+		b.waitForRequest(t, streamMethodSubscribeNewBlock)
+
+		// Drop the connection (re-subscribes after the reconnect)
+		b.drop()
+		b.waitForRequest(t, streamMethodSubscribeNewBlock)
+		assert.Equal(t, 2, b.connections())
+
+		b.notify(streamSubscriptionNewBlock, `{"height":723772,"hash":"`+testBlockHash+`"}`)
+		event, ok := receiveBlock(t, events)
+		require.True(t, ok)
+		assert.Equal(t, uint64(testBlockHeight), event.Height)
+	})
+
+	t.Run("missed pongs", func(t *testing.T) {
+		b := newTestBlockbook()
+		defer b.close()
+		b.ignorePing = true
+		options := b.options()
+		options.PingInterval = 20 * time.Millisecond
+		s := NewClient(WithAPIKey(testKey)).NewStreamClient(BTC, options)
+		defer func() {
+			_ = s.Close()
+		}()
+
+		_, err := s.SubscribeNewBlock(context.Background())
+		require.NoError(t, err)
+		b.waitForRequest(t, streamMethodSubscribeNewBlock)
+
+		// The dead connection is dropped and re-opened
+		b.waitForRequest(t, streamMethodSubscribeNewBlock)
+		assert.GreaterOrEqual(t, b.connections(), 2)
+	})
+
+	t.Run("closed stream", func(t *testing.T) {
+		b := newTestBlockbook()
+		defer b.close()
+		s := NewClient(WithAPIKey(testKey)).NewStreamClient(BTC, b.options())
+
+		events, err := s.SubscribeNewBlock(context.Background())
+		require.NoError(t, err)
+		require.NoError(t, s.Close())
+		_, ok := receiveBlock(t, events)
+		assert.False(t, ok)
+
+		events, err = s.SubscribeNewBlock(context.Background())
+		require.Nil(t, events)
+		assert.ErrorIs(t, err, ErrStreamClosed)
+	})
+
+	t.Run("unsupported chain", func(t *testing.T) {
+		b := newTestBlockbook()
+		defer b.close()
+		s := NewClient(WithAPIKey(testKey)).NewStreamClient(ETH, b.options())
+		events, err := s.SubscribeNewBlock(context.Background())
+		require.Nil(t, events)
+		assert.ErrorIs(t, err, ErrUnsupportedBlockchain)
+	})
+}
+
+func TestStreamClient_SubscribeAddresses(t *testing.T) {
+	t.Parallel()
+
+	t.Run("address transactions", func(t *testing.T) {
+		b := newTestBlockbook()
+		defer b.close()
+		s := NewClient(WithAPIKey(testKey)).NewStreamClient(BTC, b.options())
+		defer func() {
+			_ = s.Close()
+		}()
+
+		sub, err := s.SubscribeAddresses(context.Background(), []string{testBTCAddress})
+		require.NoError(t, err)
+		req := b.waitForRequest(t, streamMethodSubscribeAddresses)
+		assert.Equal(t, []string{testBTCAddress}, subscribedAddresses(t, req))
+
+		b.notify(req.ID, addressTxNotification(testBTCAddress, testBTCTxHexID))
+		event, ok := receiveAddressTx(t, sub.Events())
+		require.True(t, ok)
+		assert.Equal(t, testBTCAddress, event.Address)
+		require.NotNil(t, event.Tx)
+		assert.Equal(t, testBTCTxHexID, event.Tx.TxID)
+
+		// Invalid notifications are skipped
+		b.notify(req.ID, `{"address":"`+testBTCAddress+`"}`)
+		b.notify(req.ID, addressTxNotification(testBTCAddress, testBitcoinTxID))
+		event, ok = receiveAddressTx(t, sub.Events())
+		require.True(t, ok)
+		assert.Equal(t, testBitcoinTxID, event.Tx.TxID)
+	})
+
+	t.Run("add and remove", func(t *testing.T) {
+		b := newTestBlockbook()
+		defer b.close()
+		s := NewClient(WithAPIKey(testKey)).NewStreamClient(BTC, b.options())
+		defer func() {
+			_ = s.Close()
+		}()
+
+		sub, err := s.SubscribeAddresses(context.Background(), []string{testBTCAddress})
+		require.NoError(t, err)
+		b.waitForRequest(t, streamMethodSubscribeAddresses)
+
+		// Add (same connection)
+		require.NoError(t, sub.Add(context.Background(), testBitcoinAddress, testBTCAddress))
+		req := b.waitForRequest(t, streamMethodSubscribeAddresses)
+		assert.Equal(t, []string{testBTCAddress, testBitcoinAddress}, subscribedAddresses(t, req))
+		assert.Equal(t, []string{testBTCAddress, testBitcoinAddress}, sub.Addresses())
+
+		b.notify(req.ID, addressTxNotification(testBitcoinAddress, testBitcoinTxID))
+		event, _ := receiveAddressTx(t, sub.Events())
+		assert.Equal(t, testBitcoinAddress, event.Address)
+
+		// Remove
+		require.NoError(t, sub.Remove(context.Background(), testBTCAddress))
+		req = b.waitForRequest(t, streamMethodSubscribeAddresses)
+		assert.Equal(t, []string{testBitcoinAddress}, subscribedAddresses(t, req))
+		assert.Equal(t, []string{testBitcoinAddress}, sub.Addresses())
+
+		// Removed addresses are not delivered
+		b.notify(req.ID, addressTxNotification(testBTCAddress, testBTCTxHexID))
+		b.notify(req.ID, addressTxNotification(testBitcoinAddress, testBitcoinTxID))
+		event, _ = receiveAddressTx(t, sub.Events())
+		assert.Equal(t, testBitcoinAddress, event.Address)
+
+		// No changes (nothing sent)
+		require.NoError(t, sub.Remove(context.Background(), testBTCAddress))
+		require.NoError(t, sub.Add(context.Background()))
+		assert.Equal(t, 1, b.connections())
+
+		// Invalid address
+		assert.ErrorIs(t, sub.Add(context.Background(), "invalid"), ErrInvalidAddress)
+		assert.Equal(t, []string{testBitcoinAddress}, sub.Addresses())
+	})
+
+	t.Run("too many addresses", func(t *testing.T) {
+		b := newTestBlockbook()
+		defer b.close()
+		options := b.options()
+		options.MaxAddresses = 2
+		const testBTCP2SHAddress = "3J98t1WpEZ73CNmQviecrnyiWrnqRhWNLy"
+		s := NewClient(WithAPIKey(testKey)).NewStreamClient(BTC, options)
+		defer func() {
+			_ = s.Close()
+		}()
+
+		sub, err := s.SubscribeAddresses(context.Background(), []string{testBTCAddress})
+		require.NoError(t, err)
+		b.waitForRequest(t, streamMethodSubscribeAddresses)
+
+		// Addresses of all subscriptions are counted (duplicates only once)
+		other, err := s.SubscribeAddresses(context.Background(), []string{testBTCAddress, testBitcoinAddress})
+		require.NoError(t, err)
+		assert.ErrorIs(t, sub.Add(context.Background(), testBTCP2SHAddress), ErrTooManyAddresses)
+		assert.Equal(t, []string{testBTCAddress}, sub.Addresses())
+
+		sub2, err := s.SubscribeAddresses(context.Background(), []string{testBTCP2SHAddress})
+		require.Nil(t, sub2)
+		assert.ErrorIs(t, err, ErrTooManyAddresses)
+
+		// Room after a remove
+		require.NoError(t, other.Remove(context.Background(), testBitcoinAddress))
+		require.NoError(t, sub.Add(context.Background(), testBTCP2SHAddress))
+	})
+
+	t.Run("failed update is reverted", func(t *testing.T) {
+		b := newTestBlockbook()
+		defer b.close()
+		s := NewClient(WithAPIKey(testKey)).NewStreamClient(BTC, b.options())
+
+		sub, err := s.SubscribeAddresses(context.Background(), []string{testBTCAddress})
+		require.NoError(t, err)
+		require.NoError(t, s.Close())
+
+		assert.ErrorIs(t, sub.Add(context.Background(), testBitcoinAddress), ErrStreamClosed)
+		assert.Equal(t, []string{testBTCAddress}, sub.Addresses())
+	})
+
+	t.Run("multiple subscribers", func(t *testing.T) {
+		b := newTestBlockbook()
+		defer b.close()
+		s := NewClient(WithAPIKey(testKey)).NewStreamClient(BTC, b.options())
+		defer func() {
+			_ = s.Close()
+		}()
+
+		ctx1, cancel1 := context.WithCancel(context.Background())
+		sub1, err := s.SubscribeAddresses(ctx1, []string{testBTCAddress})
+		require.NoError(t, err)
+		b.waitForRequest(t, streamMethodSubscribeAddresses)
+		ctx2, cancel2 := context.WithCancel(context.Background())
+		defer cancel2()
+		sub2, err := s.SubscribeAddresses(ctx2, []string{testBitcoinAddress})
+		require.NoError(t, err)
+		req := b.waitForRequest(t, streamMethodSubscribeAddresses)
+		assert.Equal(t, []string{testBTCAddress, testBitcoinAddress}, subscribedAddresses(t, req))
+
+		// Each subscriber only receives its own addresses
+		b.notify(req.ID, addressTxNotification(testBTCAddress, testBTCTxHexID))
+		b.notify(req.ID, addressTxNotification(testBitcoinAddress, testBitcoinTxID))
+		event, _ := receiveAddressTx(t, sub1.Events())
+		assert.Equal(t, testBTCAddress, event.Address)
+		event, _ = receiveAddressTx(t, sub2.Events())
+		assert.Equal(t, testBitcoinAddress, event.Address)
+
+		// First subscriber is done (addresses of the second are kept)
+		cancel1()
+		_, ok := receiveAddressTx(t, sub1.Events())
+		assert.False(t, ok)
+		req = b.waitForRequest(t, streamMethodSubscribeAddresses)
+		assert.Equal(t, []string{testBitcoinAddress}, subscribedAddresses(t, req))
+		assert.ErrorIs(t, sub1.Add(context.Background(), testBTCAddress), context.Canceled)
+
+		// Last subscriber is done (unsubscribed)
+		cancel2()
+		_, ok = receiveAddressTx(t, sub2.Events())
+		assert.False(t, ok)
+		b.waitForRequest(t, streamMethodUnsubscribeAddresses)
+	})
+
+	t.Run("reconnect", func(t *testing.T) {
+		b := newTestBlockbook()
+		defer b.close()
+		s := NewClient(WithAPIKey(testKey)).NewStreamClient(BTC, b.options())
+		defer func() {
+			_ = s.Close()
+		}()
+
+		sub, err := s.SubscribeAddresses(context.Background(), []string{testBTCAddress})
+		require.NoError(t, err)
+		b.waitForRequest(t, streamMethodSubscribeAddresses)
+		require.NoError(t, sub.Add(context.Background(), testBitcoinAddress))
+		b.waitForRequest(t, streamMethodSubscribeAddresses)
+
+		// Drop the connection (re-subscribes with all addresses after the reconnect)
+		b.drop()
+		req := b.waitForRequest(t, streamMethodSubscribeAddresses)
+		assert.Equal(t, []string{testBTCAddress, testBitcoinAddress}, subscribedAddresses(t, req))
+		assert.Equal(t, 2, b.connections())
+
+		b.notify(req.ID, addressTxNotification(testBTCAddress, testBTCTxHexID))
+		event, ok := receiveAddressTx(t, sub.Events())
+		require.True(t, ok)
+		assert.Equal(t, testBTCTxHexID, event.Tx.TxID)
+	})
+
+	t.Run("bch prefix", func(t *testing.T) {
+		b := newTestBlockbook()
+		defer b.close()
+		s := NewClient(WithAPIKey(testKey)).NewStreamClient(BCH, b.options())
+		defer func() {
+			_ = s.Close()
+		}()
+
+		sub, err := s.SubscribeAddresses(context.Background(), []string{testBCHAddress})
+		require.NoError(t, err)
+		req := b.waitForRequest(t, streamMethodSubscribeAddresses)
+
+		b.notify(req.ID, addressTxNotification(strings.TrimPrefix(testBCHAddress, bitcoinCashPrefix), testBTCTxHexID))
+		event, ok := receiveAddressTx(t, sub.Events())
+		require.True(t, ok)
+		assert.Equal(t, testBTCTxHexID, event.Tx.TxID)
+	})
+
+	t.Run("invalid address", func(t *testing.T) {
+		b := newTestBlockbook()
+		defer b.close()
+		s := NewClient(WithAPIKey(testKey)).NewStreamClient(BTC, b.options())
+		sub, err := s.SubscribeAddresses(context.Background(), []string{testBTCAddress, "invalid"})
+		require.Nil(t, sub)
+		assert.ErrorIs(t, err, ErrInvalidAddress)
+		assert.Equal(t, 0, b.connections())
+	})
+
+	t.Run("unsupported chain", func(t *testing.T) {
+		b := newTestBlockbook()
+		defer b.close()
+		s := NewClient(WithAPIKey(testKey)).NewStreamClient(ETH, b.options())
+		sub, err := s.SubscribeAddresses(context.Background(), []string{testETHAddress})
+		require.Nil(t, sub)
+		assert.ErrorIs(t, err, ErrUnsupportedBlockchain)
+	})
+}
+
+func ExampleStreamClient_SubscribeNewBlock() {
+	b := newTestBlockbook()
+	defer b.close()
+
+	s := NewClient(WithAPIKey(testKey)).NewStreamClient(BTC, b.options())
+	defer func() {
+		_ = s.Close()
+	}()
+
+	events, err := s.SubscribeNewBlock(context.Background())
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	b.notify(streamSubscriptionNewBlock, `{"height":723772,"hash":"`+testBlockHash+`"}`)
+	event := <-events
+	fmt.Printf("new block: %d", event.Height)
+	// Output:new block: 723772
+}
+
+func ExampleStreamClient_SubscribeAddresses() {
+	b := newTestBlockbook()
+	defer b.close()
+
+	s := NewClient(WithAPIKey(testKey)).NewStreamClient(BTC, b.options())
+	defer func() {
+		_ = s.Close()
+	}()
+
+	sub, err := s.SubscribeAddresses(context.Background(), []string{testBTCAddress})
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	b.notify(streamSubscriptionAddresses, addressTxNotification(testBTCAddress, testBTCTxHexID))
+	event := <-sub.Events()
+	fmt.Printf("address tx: %s", event.Tx.TxID)
+	// Output:address tx: 4e475f486c5aad520d113448f70980f40e9b4976e4664bb3a3ed7d14c00ba639
+}
+
+func BenchmarkStreamClient_Ping(b *testing.B) {
+	blockbook := newTestBlockbook()
+	defer blockbook.close()
+
+	s := NewClient(WithAPIKey(testKey)).NewStreamClient(BTC, blockbook.options())
+	defer func() {
+		_ = s.Close()
+	}()
+	ctx := context.Background()
+	for i := 0; i < b.N; i++ {
+		_ = s.Ping(ctx)
+	}
+}
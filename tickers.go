@@ -0,0 +1,82 @@
+package nownodes
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// TickerInfo is the fiat rates returned to the GetTickers request
+type TickerInfo struct {
+	Rates     map[string]float64 `json:"rates"` // Rates by currency (IE: usd: 7914.5)
+	Timestamp int64              `json:"ts"`    // Time of the rates (unix timestamp)
+}
+
+// TickersList is the list of currencies returned to the GetTickersList request
+type TickersList struct {
+	AvailableCurrencies []string `json:"available_currencies"`
+	Timestamp           int64    `json:"ts"` // Time of the rates (unix timestamp)
+}
+
+// GetTickers will get the fiat rates closest to the given timestamp
+//
+// param: timestamp is optional (0 will return the current rates)
+// param: currencies is optional (empty will return all available currencies)
+// This method supports the following chains: BCH, BSV, BTC, BTCTestnet, BTG, DASH, DOGE, LTC
+func (c *Client) GetTickers(ctx context.Context, chain Blockchain, timestamp int64,
+	currencies []string) (*TickerInfo, error) {
+
+	// BlockBook only filters by a single currency
+	values := tickerValues(timestamp)
+	if len(currencies) == 1 {
+		values.Set("currency", strings.ToLower(currencies[0]))
+	}
+
+	// Fire the HTTP request
+	info := new(TickerInfo)
+	if err := blockBookRequest(
+		ctx, c, getTickersBlockchains, chain, routeGetTickers+encodeQuery(values), &info,
+	); err != nil {
+		return nil, err
+	}
+
+	// Filter the rates to the given currencies
+	if len(currencies) > 1 {
+		rates := make(map[string]float64, len(currencies))
+		for _, currency := range currencies {
+			currency = strings.ToLower(currency)
+			if rate, ok := info.Rates[currency]; ok {
+				rates[currency] = rate
+			}
+		}
+		info.Rates = rates
+	}
+	return info, nil
+}
+
+// GetTickersList will get the list of available currencies closest to the given timestamp
+//
+// param: timestamp is optional (0 will return the current list)
+// This method supports the following chains: BCH, BSV, BTC, BTCTestnet, BTG, DASH, DOGE, LTC
+func (c *Client) GetTickersList(ctx context.Context, chain Blockchain, timestamp int64) (*TickersList, error) {
+
+	// Fire the HTTP request
+	list := new(TickersList)
+	if err := blockBookRequest(
+		ctx, c, getTickersListBlockchains, chain,
+		routeGetTickersList+encodeQuery(tickerValues(timestamp)), &list,
+	); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// tickerValues will return the url values for the ticker requests
+func tickerValues(timestamp int64) url.Values {
+	values := url.Values{}
+	if timestamp > 0 {
+		values.Set("timestamp", strconv.FormatInt(timestamp, 10))
+	}
+	return values
+}
@@ -0,0 +1,197 @@
+package nownodes
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// validTickersResponse will return valid tickers for all supported blockchains
+type validTickersResponse struct{}
+
+func (v *validTickersResponse) Do(req *http.Request) (*http.Response, error) {
+	resp := new(http.Response)
+	resp.StatusCode = http.StatusBadRequest
+
+	// No req found
+	if req == nil {
+		return resp, errors.New("missing request")
+	}
+
+	// Requested time (or current time)
+	ts := req.URL.Query().Get("timestamp")
+	if len(ts) == 0 {
+		ts = "1643486938"
+	}
+
+	// Valid response (tickers list)
+	for _, chain := range getTickersListBlockchains {
+		if strings.Contains(req.Host, chain.BlockBookURL()) && strings.Contains(req.URL.String(), routeGetTickersList) {
+			resp.StatusCode = http.StatusOK
+			resp.Body = io.NopCloser(bytes.NewBuffer([]byte(`{"ts":` + ts + `,"available_currencies":["eur","gbp","jpy","usd"]}`)))
+			return resp, nil
+		}
+	}
+
+	// Valid response (tickers)
+	for _, chain := range getTickersBlockchains {
+		if strings.Contains(req.Host, chain.BlockBookURL()) && strings.Contains(req.URL.String(), routeGetTickers) {
+			rates := `{"eur":33570.12,"gbp":28010.4,"jpy":4329511.5,"usd":37640.61}`
+			if currency := req.URL.Query().Get("currency"); len(currency) > 0 {
+				rates = `{"` + currency + `":37640.61}`
+			}
+			resp.StatusCode = http.StatusOK
+			resp.Body = io.NopCloser(bytes.NewBuffer([]byte(`{"ts":` + ts + `,"rates":` + rates + `}`)))
+			return resp, nil
+		}
+	}
+
+	resp.Body = io.NopCloser(bytes.NewBuffer([]byte(`{"error":"no-route-found"}`)))
+	return resp, errors.New("request not found")
+}
+
+func TestClient_GetTickers(t *testing.T) {
+	t.Parallel()
+
+	t.Run("valid cases", func(t *testing.T) {
+		c := NewClient(WithAPIKey(testKey), WithHTTPClient(&validTickersResponse{}))
+		ctx := context.Background()
+
+		for _, chain := range getTickersBlockchains {
+			t.Run("chain "+chain.String()+": GetTickers()", func(t *testing.T) {
+				info, err := c.GetTickers(ctx, chain, 0, nil)
+				require.NoError(t, err)
+				require.NotNil(t, info)
+				assert.Equal(t, int64(1643486938), info.Timestamp)
+				assert.Len(t, info.Rates, 4)
+				assert.InDelta(t, 37640.61, info.Rates["usd"], 0.001)
+			})
+		}
+	})
+
+	t.Run("single currency at a timestamp", func(t *testing.T) {
+		c := NewClient(WithHTTPClient(&validTickersResponse{}))
+		info, err := c.GetTickers(context.Background(), BTC, 1643111792, []string{"USD"})
+		require.NoError(t, err)
+		require.NotNil(t, info)
+		assert.Equal(t, int64(1643111792), info.Timestamp)
+		assert.Equal(t, map[string]float64{"usd": 37640.61}, info.Rates)
+	})
+
+	t.Run("multiple currencies", func(t *testing.T) {
+		c := NewClient(WithHTTPClient(&validTickersResponse{}))
+		info, err := c.GetTickers(context.Background(), BTC, 0, []string{"usd", "eur", "cad"})
+		require.NoError(t, err)
+		require.NotNil(t, info)
+		assert.Equal(t, map[string]float64{"eur": 33570.12, "usd": 37640.61}, info.Rates)
+	})
+
+	t.Run("unsupported chain", func(t *testing.T) {
+		c := NewClient(WithHTTPClient(&validTickersResponse{}))
+		info, err := c.GetTickers(context.Background(), ETH, 0, nil)
+		require.Error(t, err)
+		require.Nil(t, info)
+		assert.ErrorIs(t, err, ErrUnsupportedBlockchain)
+	})
+
+	t.Run("error cases", func(t *testing.T) {
+		for _, chain := range getTickersBlockchains {
+			t.Run("chain "+chain.String()+": http req error", func(t *testing.T) {
+				c := NewClient(WithHTTPClient(&errorDoReqErr{}))
+				info, err := c.GetTickers(context.Background(), chain, 0, nil)
+				require.Error(t, err)
+				require.Nil(t, info)
+			})
+
+			t.Run("chain "+chain.String()+": invalid json response", func(t *testing.T) {
+				c := NewClient(WithHTTPClient(&errorBadJSONResponse{}))
+				info, err := c.GetTickers(context.Background(), chain, 0, nil)
+				require.Error(t, err)
+				require.Nil(t, info)
+			})
+		}
+	})
+}
+
+func ExampleClient_GetTickers() {
+	c := NewClient(WithHTTPClient(&validTickersResponse{}))
+	info, _ := c.GetTickers(context.Background(), BSV, 1643111792, []string{"usd"})
+	fmt.Printf("usd rate: %.2f", info.Rates["usd"])
+	// Output:usd rate: 37640.61
+}
+
+func BenchmarkClient_GetTickers(b *testing.B) {
+	c := NewClient(WithHTTPClient(&validTickersResponse{}))
+	ctx := context.Background()
+	for i := 0; i < b.N; i++ {
+		_, _ = c.GetTickers(ctx, BSV, 0, nil)
+	}
+}
+
+func TestClient_GetTickersList(t *testing.T) {
+	t.Parallel()
+
+	t.Run("valid cases", func(t *testing.T) {
+		c := NewClient(WithAPIKey(testKey), WithHTTPClient(&validTickersResponse{}))
+		ctx := context.Background()
+
+		for _, chain := range getTickersListBlockchains {
+			t.Run("chain "+chain.String()+": GetTickersList()", func(t *testing.T) {
+				list, err := c.GetTickersList(ctx, chain, 1643111792)
+				require.NoError(t, err)
+				require.NotNil(t, list)
+				assert.Equal(t, int64(1643111792), list.Timestamp)
+				assert.Equal(t, []string{"eur", "gbp", "jpy", "usd"}, list.AvailableCurrencies)
+			})
+		}
+	})
+
+	t.Run("unsupported chain", func(t *testing.T) {
+		c := NewClient(WithHTTPClient(&validTickersResponse{}))
+		list, err := c.GetTickersList(context.Background(), ETH, 0)
+		require.Error(t, err)
+		require.Nil(t, list)
+		assert.ErrorIs(t, err, ErrUnsupportedBlockchain)
+	})
+
+	t.Run("error cases", func(t *testing.T) {
+		for _, chain := range getTickersListBlockchains {
+			t.Run("chain "+chain.String()+": http req error", func(t *testing.T) {
+				c := NewClient(WithHTTPClient(&errorDoReqErr{}))
+				list, err := c.GetTickersList(context.Background(), chain, 0)
+				require.Error(t, err)
+				require.Nil(t, list)
+			})
+
+			t.Run("chain "+chain.String()+": invalid json response", func(t *testing.T) {
+				c := NewClient(WithHTTPClient(&errorBadJSONResponse{}))
+				list, err := c.GetTickersList(context.Background(), chain, 0)
+				require.Error(t, err)
+				require.Nil(t, list)
+			})
+		}
+	})
+}
+
+func ExampleClient_GetTickersList() {
+	c := NewClient(WithHTTPClient(&validTickersResponse{}))
+	list, _ := c.GetTickersList(context.Background(), BSV, 0)
+	fmt.Printf("currencies: %d", len(list.AvailableCurrencies))
+	// Output:currencies: 4
+}
+
+func BenchmarkClient_GetTickersList(b *testing.B) {
+	c := NewClient(WithHTTPClient(&validTickersResponse{}))
+	ctx := context.Background()
+	for i := 0; i < b.N; i++ {
+		_, _ = c.GetTickersList(ctx, BSV, 0)
+	}
+}
@@ -6,19 +6,54 @@ import (
 
 // TransactionInfo is the transaction information returned to the GetTransaction request
 type TransactionInfo struct {
-	BlockHash     string    `json:"blockHash"`
-	BlockHeight   int64     `json:"blockHeight"`
-	BlockTime     int64     `json:"blockTime"`
-	Confirmations int64     `json:"confirmations"`
-	Fees          string    `json:"fees"`
-	Hex           string    `json:"hex"`
-	LockTime      int64     `json:"lockTime,omitempty"` // BTC
-	TxID          string    `json:"txid"`
-	Value         string    `json:"value"`
-	ValueIn       string    `json:"valueIn"`
-	Version       int8      `json:"version"`
-	Vin           []*Input  `json:"vin"`
-	VOut          []*Output `json:"vout"`
+	BlockHash        string            `json:"blockHash"`
+	BlockHeight      int64             `json:"blockHeight"`
+	BlockTime        int64             `json:"blockTime"`
+	Confirmations    int64             `json:"confirmations"`
+	EthereumSpecific *EthereumSpecific `json:"ethereumSpecific,omitempty"` // ETH
+	Fees             string            `json:"fees"`
+	Hex              string            `json:"hex"`
+	LockTime         int64             `json:"lockTime,omitempty"`       // BTC
+	TokenTransfers   []*TokenTransfer  `json:"tokenTransfers,omitempty"` // ETH
+	TxID             string            `json:"txid"`
+	Value            string            `json:"value"`
+	ValueIn          string            `json:"valueIn"`
+	Version          int8              `json:"version"`
+	Vin              []*Input          `json:"vin"`
+	VOut             []*Output         `json:"vout"`
+}
+
+// EthereumTxStatus is the status of an Ethereum transaction
+type EthereumTxStatus int64
+
+// Ethereum transaction statuses
+const (
+	EthereumTxPending EthereumTxStatus = -1 // Not mined yet
+	EthereumTxFailure EthereumTxStatus = 0  // Mined but reverted
+	EthereumTxSuccess EthereumTxStatus = 1  // Mined and executed
+)
+
+// EthereumSpecific is the Ethereum specific transaction information
+type EthereumSpecific struct {
+	Data     string           `json:"data,omitempty"` // Input data (hex)
+	GasLimit uint64           `json:"gasLimit"`
+	GasPrice string           `json:"gasPrice"` // In wei
+	GasUsed  uint64           `json:"gasUsed"`  // Zero if pending
+	Nonce    uint64           `json:"nonce"`
+	Status   EthereumTxStatus `json:"status"`
+}
+
+// TokenTransfer is an ERC20 (or other token standard) transfer within an Ethereum transaction
+type TokenTransfer struct {
+	Contract string `json:"contract,omitempty"` // Newer BlockBook versions
+	Decimals uint64 `json:"decimals"`
+	From     string `json:"from"`
+	Name     string `json:"name"`
+	Symbol   string `json:"symbol"`
+	To       string `json:"to"`
+	Token    string `json:"token,omitempty"` // Contract address (older BlockBook versions)
+	Type     string `json:"type"`            // IE: ERC20
+	Value    string `json:"value"`           // In the smallest token unit
 }
 
 // Input is the transaction input
@@ -51,16 +86,41 @@ type BroadcastResult struct {
 	Result string `json:"result,omitempty"` // The Tx ID {"result": "15e78db3a6247ca320de2202240f6a4877ea3af338e23bf5ff3e5cbff3763bf6"}
 }
 
+// SendOps allow functional options to be supplied when broadcasting a transaction
+type SendOps func(o *SendOptions)
+
+// SendOptions are the options for broadcasting a transaction
+type SendOptions struct {
+	dryRun bool
+}
+
+// WithDryRun will check the transaction with TestMempoolAccept before broadcasting
+//
+// If the node would reject the transaction (or returns no result), a *RejectError (or ErrMissingMempoolAcceptResult)
+// is returned and nothing is broadcast
+func WithDryRun() SendOps {
+	return func(o *SendOptions) {
+		o.dryRun = true
+	}
+}
+
 // GetTransaction will get transaction information by a given TxID
 //
-// This method supports the following chains: BCH, BSV, BTC, BTCTestnet, BTG, DASH, DOGE, LTC
+// This method supports the following chains: BCH, BSV, BTC, BTCTestnet, BTG, DASH, DOGE, ETH, LTC
 func (c *Client) GetTransaction(ctx context.Context, chain Blockchain, txID string) (*TransactionInfo, error) {
 
 	// Validate the input
-	if !chain.ValidateTxID(txID) {
+	if !isBlockchainSupported(getTransactionBlockchains, chain) {
+		return nil, ErrUnsupportedBlockchain
+	} else if !chain.ValidateTxID(txID) {
 		return nil, ErrInvalidTxID
 	}
 
+	// Use the WebSocket (if enabled)
+	if stream := c.streamTransport(chain); stream != nil {
+		return stream.GetTransaction(ctx, txID)
+	}
+
 	// Fire the HTTP request
 	info := new(TransactionInfo)
 	if err := blockBookRequest(
@@ -82,6 +142,11 @@ func (c *Client) SendTransaction(ctx context.Context, chain Blockchain, txHex st
 		return nil, ErrInvalidTxHex
 	}
 
+	// Use the WebSocket (if enabled, there is no size limit)
+	if stream := c.streamTransport(chain); stream != nil {
+		return stream.SendTransaction(ctx, txHex)
+	}
+
 	// Max size of a GET request: 2048 (not sure how NowNodes is handling this)
 	if len(txHex) > maxTxHexLengthOnSend {
 		return c.SendRawTransaction(ctx, chain, txHex, hashString(txHex))
@@ -100,25 +165,48 @@ func (c *Client) SendTransaction(ctx context.Context, chain Blockchain, txHex st
 // SendRawTransaction will submit a broadcast request (POST) with the given tx hex payload
 //
 // param: id is a unique identifier for your own use
+// param: opts are optional (IE: WithDryRun())
 // This method supports the following chains: BSV, BTC, BTCTestnet, BTG, DASH, DOGE, LTC
-func (c *Client) SendRawTransaction(ctx context.Context, chain Blockchain, txHex, id string) (*BroadcastResult, error) {
+func (c *Client) SendRawTransaction(ctx context.Context, chain Blockchain, txHex, id string,
+	opts ...SendOps) (*BroadcastResult, error) {
 
 	// Validate the input
 	if !chain.ValidateTxHex(txHex) {
 		return nil, ErrInvalidTxHex
 	}
 
+	// Load the options
+	options := new(SendOptions)
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	// Check that the node would accept the tx before broadcasting
+	if options.dryRun {
+		results, err := c.TestMempoolAccept(ctx, chain, []string{txHex}, 0)
+		if err != nil {
+			return nil, err
+		}
+		if len(results) != 1 || results[0] == nil {
+			return nil, ErrMissingMempoolAcceptResult
+		} else if !results[0].Allowed {
+			return nil, &RejectError{Reason: results[0].RejectReason, TxID: results[0].TxID}
+		}
+	}
+
 	// Empty id?
 	if len(id) == 0 {
 		id = hashString(txHex)
 	}
 
 	// Fire the HTTP request
+	payload, err := createPayload(c.options.apiKey, nodeMethodSendRawTx, id, []interface{}{txHex})
+	if err != nil {
+		return nil, err
+	}
 	result := new(BroadcastResult)
-	if err := nodeRequest(
-		ctx, c, sendRawTransactionBlockchains, chain,
-		createPayload(c.options.apiKey, nodeMethodSendRawTx, id, []string{txHex}),
-		&result,
+	if err = nodeRequest(
+		ctx, c, sendRawTransactionBlockchains, chain, payload, &result,
 	); err != nil {
 		return nil, err
 	}
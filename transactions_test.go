@@ -34,6 +34,7 @@ func (v *validTxResponse) Do(req *http.Request) (*http.Response, error) {
 		BTG.String():  `{"txid":"934989d8e6e1fe9bc3d7508479df85e4757e6f0ceee613e7f8736e4e6b344a4a","version":1,"vin":[{"txid":"4ac8d33e95c3944428ede85c68912951f6097022974ff4b7cf7e274dbf534686","sequence":4294967295,"n":0,"addresses":["ATTav2PtmotBZwxgWjrZCgaZpE89kcJ29B"],"isAddress":true,"value":"759789817","hex":"1600143c0829804f4c55122d8f403f614ce4f845290fdb"}],"vout":[{"value":"14276945","n":0,"hex":"76a9140cb60a52559620e5de9a297612d49f55f7fd14ea88ac","addresses":["GK18bp4UzC6wqYKKNLkaJ3hzQazTc3TWBw"],"isAddress":true},{"value":"745512674","n":1,"hex":"a9148bcd7f6402f5fd50f34850e2c5f2e45e4c1702c887","addresses":["AUX5kPSTQeosDXmTroBZPLHv7NNXZYZkvX"],"isAddress":true}],"blockHash":"0000000174db2a8a13531cd8a7f42a3a2eca5c3e4b2a909a196dcb5b9dc382d1","blockHeight":723261,"confirmations":11,"blockTime":1643483952,"value":"759789619","valueIn":"759789817","fees":"198","hex":"01000000000101864653bf4d277ecfb7f44f97227009f6512991685ce8ed284494c3953ed3c84a00000000171600143c0829804f4c55122d8f403f614ce4f845290fdbffffffff0251d9d900000000001976a9140cb60a52559620e5de9a297612d49f55f7fd14ea88ace29e6f2c0000000017a9148bcd7f6402f5fd50f34850e2c5f2e45e4c1702c88702483045022100b23f6fbadf3c4b22ccaa7245cb8c1cb4340f32667ee4069a1a843f7681a24d080220199f827e9701b281f62cddb42df6de35406c82b2c2e7f3b4cdaf0034c7b23fef412103d0c56dd160c29607cf4463d619822946666f9998b2ae86b54a5821cab704f2b300000000"}`,
 		DASH.String(): `{"txid":"7c4738c76ba318e74af3e6f85f94ac15b44b4189bf76a9523aed71366a75445e","version":2,"lockTime":1613396,"vin":[{"txid":"b9e4cebbf1cde3118ec62df72553a7d60fad78565028e8bf1c31edec23a1a393","sequence":4294967294,"n":0,"addresses":["Xe7tPVUvDpt52h2KykMpj4hmh8VsAaPCgt"],"isAddress":true,"value":"54100000","hex":"4830450221009ceb5a9f743de29353e077ef642b4a741d88d60e8737918ed7e060b6017750af022062e5b15f4ac3f9f5ea97124d3ce3e351a950f7018adb22d28b3a0bb86594998f0121036fdd18e0e1ff3989431beac0aef1a5e75f51d745ce4a54afd8a3a1968592275d"}],"vout":[{"value":"54099776","n":0,"hex":"a914581cbcc7c2a93077d836c232130cfbcf3987f0ce87","addresses":["7aSYeL7uF9HtxVYiTX8Ew6wFYkcE3veAqj"],"isAddress":true}],"blockHash":"000000000000001e507180f6ab9aa1d0541d562592af4e5c77a60427c4e174e9","blockHeight":1613398,"confirmations":7,"blockTime":1643487799,"value":"54099776","valueIn":"54100000","fees":"224","hex":"020000000193a3a123eced311cbfe828505678ad0fd6a75325f72dc68e11e3cdf1bbcee4b9000000006b4830450221009ceb5a9f743de29353e077ef642b4a741d88d60e8737918ed7e060b6017750af022062e5b15f4ac3f9f5ea97124d3ce3e351a950f7018adb22d28b3a0bb86594998f0121036fdd18e0e1ff3989431beac0aef1a5e75f51d745ce4a54afd8a3a1968592275dfeffffff01407f39030000000017a914581cbcc7c2a93077d836c232130cfbcf3987f0ce87549e1800"}`,
 		DOGE.String(): `{"txid":"6b22cc41b1206b6f39568bca5ca9e32ca3e0f6f4e0a68e2b126913e7d6620543","version":1,"vin":[{"txid":"44e93228de0618bd425e30657485ac0eebb56e205905698c9a139bdb0092e964","vout":1,"sequence":4294967295,"n":0,"addresses":["ACSbgj91BsjdpuG6pBkG9LXtCTFaH4mn5a"],"isAddress":true,"value":"6894572842640","hex":"004730440220646f43d6d57b850206a8dde6750cd3a6ba4f77cd26452505757a49f0345fd547022057d093128e611a2d1c732058f38a4528dffaf13bf8bf221f03c17165afdd79360147304402203f49525951952b192559767c7b6228a24527657786dae173aab92d883405f56a02207f70dec8ea9a15078ea11f6737c21be45e5059e421e53035bd1390f3290661920147522102adf2cb5afd730171a425d263014e9307d71edc352b4c3c9b750eecdc95ef70d021027105672d0cf8269ca3ea757754049eeec8da3a7e963d2786f06547cd78c28be252ae"}],"vout":[{"value":"88041747863","n":0,"spent":true,"hex":"76a91473d7fc810d7d02988219702c5296eed5e2f9449988ac","addresses":["DFhczK7w4gjGrNjFTgLFEYbL8Zs2YQA1dQ"],"isAddress":true},{"value":"6806530086777","n":1,"hex":"a914db72653436f25884f2ab2bf050d06e805907dd0e87","addresses":["ACSbgj91BsjdpuG6pBkG9LXtCTFaH4mn5a"],"isAddress":true}],"blockHash":"cf67498603ab0c8dd66324688dc69d1e4d3edd4e894ea993cbf2931659d59b36","blockHeight":4082794,"confirmations":9,"blockTime":1643487708,"value":"6894571834640","valueIn":"6894572842640","fees":"1008000","hex":"010000000164e99200db9b139a8c690559206eb5eb0eac857465305e42bd1806de2832e94401000000d9004730440220646f43d6d57b850206a8dde6750cd3a6ba4f77cd26452505757a49f0345fd547022057d093128e611a2d1c732058f38a4528dffaf13bf8bf221f03c17165afdd79360147304402203f49525951952b192559767c7b6228a24527657786dae173aab92d883405f56a02207f70dec8ea9a15078ea11f6737c21be45e5059e421e53035bd1390f3290661920147522102adf2cb5afd730171a425d263014e9307d71edc352b4c3c9b750eecdc95ef70d021027105672d0cf8269ca3ea757754049eeec8da3a7e963d2786f06547cd78c28be252aeffffffff029775b27f140000001976a91473d7fc810d7d02988219702c5296eed5e2f9449988ac79d7cec43006000017a914db72653436f25884f2ab2bf050d06e805907dd0e8700000000"}`,
+		ETH.String():  `{"txid":"0x193f9293a30bf668e3edd2290d49534770e6118faa201fe97da498cd8a995765","vin":[{"n":0,"addresses":["0x7dbF304559293bDccAc7cb18CB69375719b5E1CC"],"isAddress":true}],"vout":[{"value":"0","n":0,"addresses":["0xA1C13E02a8b3F833D7B47Fa57BA6484f656Ee067"],"isAddress":true}],"blockHash":"0x5c8a2c3b0e16e0c6b8a0b8b0ef8f8ad2f0b8fdbb9a5e2c8d3b6a8e4c1f0d2a31","blockHeight":14093445,"confirmations":12,"blockTime":1643487922,"value":"0","fees":"4542381934086080","ethereumSpecific":{"status":1,"nonce":69,"gasLimit":217261,"gasUsed":42496,"gasPrice":"106890456380","data":"0x10abbfae00000000000000000000000002f30927eb29f3f66031517bb3de3948f32ee01900000000000000000000000000000000000000000000000000000000000001f4"},"tokenTransfers":[{"type":"ERC20","from":"0x7dbF304559293bDccAc7cb18CB69375719b5E1CC","to":"0x02F30927eB29f3f66031517Bb3De3948f32ee019","contract":"0xA1C13E02a8b3F833D7B47Fa57BA6484f656Ee067","name":"Tether USD","symbol":"USDT","decimals":6,"value":"500"}]}`,
 		LTC.String():  `{"txid":"dfca839b7686a458e94001e53df4bd3bbe967d7ba5622f67b38cd6e2650bb37a","version":1,"vin":[{"txid":"95f0a38d47ea3fd21d467a683ef744391d777000ac1dec96f2bd24759cbd76f5","vout":1,"sequence":4294967295,"n":0,"addresses":["ltc1q684prk03qaz2uqenylktaq5z9qcg3dz7fgg0ps"],"isAddress":true,"value":"4890906"}],"vout":[{"value":"100000","n":0,"hex":"a914777762c97ceb6cd2ec0eded08868bd953e838f7987","addresses":["MJnqfLNiC3LvH2efxjP4yNjdKbVgpGf3Ar"],"isAddress":true},{"value":"4785482","n":1,"spent":true,"hex":"0014d1ea11d9f10744ae033327ecbe8282283088b45e","addresses":["ltc1q684prk03qaz2uqenylktaq5z9qcg3dz7fgg0ps"],"isAddress":true}],"blockHash":"b8f2cb74105dd4e7b8850bc1743cf3174f7365ef5bce6f015a83e8918e2ad58f","blockHeight":2202057,"confirmations":7,"blockTime":1643487498,"value":"4885482","valueIn":"4890906","fees":"5424","hex":"01000000000101f576bd9c7524bdf296ec1dac0070771d3944f73e687a461dd23fea478da3f0950100000000ffffffff02a08601000000000017a914777762c97ceb6cd2ec0eded08868bd953e838f79874a05490000000000160014d1ea11d9f10744ae033327ecbe8282283088b45e02483045022100fd62f1f896e0ec3d75e84c977f91d163fe28bc576827bd39015ef507ea4bb3ee02201ff0f5baa4a2336bbb9bc92029d10dfa82db636b5ca178d3a7b621dfefdd8ac4012102c4303428959c2d86c3c742586651f384685b99ee007a186e7a1326f5548c682e00000000"}`,
 	}
 
@@ -463,12 +464,43 @@ func TestClient_GetTransaction(t *testing.T) {
 	t.Run("unsupported chain", func(t *testing.T) {
 		c := NewClient(WithHTTPClient(&validTxResponse{}))
 		ctx := context.Background()
-		info, err := c.GetTransaction(ctx, ETH, testTxID(ETH))
+		info, err := c.GetTransaction(ctx, Blockchain("unknown"), testTxID(BTC))
 		require.Error(t, err)
 		require.Nil(t, info)
 		assert.ErrorIs(t, err, ErrUnsupportedBlockchain)
 	})
 
+	t.Run("ethereum", func(t *testing.T) {
+		c := NewClient(WithHTTPClient(&validTxResponse{}))
+		info, err := c.GetTransaction(context.Background(), ETH, testTxID(ETH))
+		require.NoError(t, err)
+		require.NotNil(t, info)
+		assert.Equal(t, testETHTxID, info.TxID)
+		assert.Equal(t, int64(14093445), info.BlockHeight)
+
+		// Ethereum specific
+		require.NotNil(t, info.EthereumSpecific)
+		assert.Equal(t, EthereumTxSuccess, info.EthereumSpecific.Status)
+		assert.Equal(t, uint64(69), info.EthereumSpecific.Nonce)
+		assert.Equal(t, uint64(217261), info.EthereumSpecific.GasLimit)
+		assert.Equal(t, uint64(42496), info.EthereumSpecific.GasUsed)
+		assert.Equal(t, "106890456380", info.EthereumSpecific.GasPrice)
+		assert.True(t, strings.HasPrefix(info.EthereumSpecific.Data, "0x10abbfae"))
+
+		// Token transfers
+		require.Len(t, info.TokenTransfers, 1)
+		assert.Equal(t, &TokenTransfer{
+			Contract: "0xA1C13E02a8b3F833D7B47Fa57BA6484f656Ee067",
+			Decimals: 6,
+			From:     "0x7dbF304559293bDccAc7cb18CB69375719b5E1CC",
+			Name:     "Tether USD",
+			Symbol:   "USDT",
+			To:       "0x02F30927eB29f3f66031517Bb3De3948f32ee019",
+			Type:     "ERC20",
+			Value:    "500",
+		}, info.TokenTransfers[0])
+	})
+
 	t.Run("error cases", func(t *testing.T) {
 
 		type testData struct {
@@ -845,6 +877,62 @@ func TestClient_SendRawTransaction(t *testing.T) {
 			})
 		}
 	})
+	t.Run("dry run", func(t *testing.T) {
+		for _, chain := range testMempoolAcceptBlockchains {
+			t.Run("chain "+chain.String()+": accepted", func(t *testing.T) {
+				mock := &mempoolAcceptResponse{}
+				c := NewClient(WithHTTPClient(mock))
+				results, err := c.SendRawTransaction(context.Background(), chain, testTxHex(chain), testUniqueID, WithDryRun())
+				require.NoError(t, err)
+				require.NotNil(t, results)
+				assert.Equal(t, testTxHexID(chain), results.Result)
+				assert.Equal(t, 1, mock.broadcasts)
+			})
+
+			t.Run("chain "+chain.String()+": rejected", func(t *testing.T) {
+				mock := &mempoolAcceptResponse{rejectReason: "bad-txns-inputs-missingorspent"}
+				c := NewClient(WithHTTPClient(mock))
+				results, err := c.SendRawTransaction(context.Background(), chain, testTxHex(chain), testUniqueID, WithDryRun())
+				require.Error(t, err)
+				require.Nil(t, results)
+				assert.Equal(t, 0, mock.broadcasts)
+
+				var rejectErr *RejectError
+				require.ErrorAs(t, err, &rejectErr)
+				assert.Equal(t, testTxHexID(chain), rejectErr.TxID)
+				assert.ErrorIs(t, err, ErrRPCMissingInputs)
+			})
+
+			t.Run("chain "+chain.String()+": already known", func(t *testing.T) {
+				mock := &mempoolAcceptResponse{rejectReason: "txn-already-in-mempool"}
+				c := NewClient(WithHTTPClient(mock))
+				results, err := c.SendRawTransaction(context.Background(), chain, testTxHex(chain), testUniqueID, WithDryRun())
+				require.Nil(t, results)
+				assert.ErrorIs(t, err, ErrRPCTxAlreadyKnown)
+				assert.NotErrorIs(t, err, ErrRPCMissingInputs)
+				assert.Equal(t, 0, mock.broadcasts)
+			})
+
+			t.Run("chain "+chain.String()+": missing result", func(t *testing.T) {
+				mock := &mempoolAcceptResponse{noResults: true}
+				c := NewClient(WithHTTPClient(mock))
+				results, err := c.SendRawTransaction(context.Background(), chain, testTxHex(chain), testUniqueID, WithDryRun())
+				require.Nil(t, results)
+				assert.ErrorIs(t, err, ErrMissingMempoolAcceptResult)
+				assert.Equal(t, 0, mock.broadcasts)
+			})
+		}
+
+		t.Run("unsupported chain", func(t *testing.T) {
+			mock := &mempoolAcceptResponse{}
+			c := NewClient(WithHTTPClient(mock))
+			results, err := c.SendRawTransaction(context.Background(), DOGE, testTxHex(DOGE), testUniqueID, WithDryRun())
+			require.Error(t, err)
+			require.Nil(t, results)
+			assert.ErrorIs(t, err, ErrUnsupportedBlockchain)
+			assert.Equal(t, 0, mock.broadcasts)
+		})
+	})
 }
 
 func ExampleClient_SendRawTransaction() {
@@ -0,0 +1,81 @@
+package nownodes
+
+import (
+	"context"
+)
+
+// UTXO is an unspent transaction output returned to the GetUTXOs request
+type UTXO struct {
+	Address       string `json:"address,omitempty"` // Only for xpub requests
+	Coinbase      bool   `json:"coinbase,omitempty"`
+	Confirmations int64  `json:"confirmations"`
+	Height        uint64 `json:"height,omitempty"`
+	LockTime      int64  `json:"lockTime,omitempty"`
+	Path          string `json:"path,omitempty"` // Only for xpub requests
+	TxID          string `json:"txid"`
+	Value         string `json:"value"`
+	VOut          uint64 `json:"vout"`
+}
+
+// TxOut is an unspent transaction output returned to the GetTxOut request
+type TxOut struct {
+	BestBlock     string        `json:"bestblock"` // The block hash at the tip of the chain
+	Coinbase      bool          `json:"coinbase"`
+	Confirmations int64         `json:"confirmations"` // Zero if the output is in the mempool
+	ScriptPubKey  *ScriptPubKey `json:"scriptPubKey"`
+	Value         float64       `json:"value"` // In coins (not satoshis)
+}
+
+// GetUTXOs will get the unspent transaction outputs for a given address or xpub
+//
+// param: confirmedOnly will exclude the unconfirmed (mempool) outputs
+// This method supports the following chains: BCH, BSV, BTC, BTCTestnet, BTG, DASH, DOGE, LTC
+func (c *Client) GetUTXOs(ctx context.Context, chain Blockchain, addressOrXPub string,
+	confirmedOnly bool) ([]*UTXO, error) {
+
+	// Validate the input (xpub or address)
+	if !chain.ValidateXPub(addressOrXPub) && !chain.ValidateAddress(addressOrXPub) {
+		return nil, ErrInvalidAddress
+	}
+
+	// Exclude the unconfirmed outputs?
+	endpoint := routeGetUTXO + addressOrXPub
+	if confirmedOnly {
+		endpoint += "?confirmed=true"
+	}
+
+	// Fire the HTTP request
+	var utxos []*UTXO
+	if err := blockBookRequest(
+		ctx, c, getUTXOsBlockchains, chain, endpoint, &utxos,
+	); err != nil {
+		return nil, err
+	}
+	return utxos, nil
+}
+
+// GetTxOut will get an unspent transaction output by a given txID and output index (vout)
+//
+// ErrTxOutNotFound is returned if the output is spent or does not exist
+// param: includeMempool will also check the mempool (an output spent in the mempool is not found)
+// This method supports the following chains: BCH, BSV, BTC, BTCTestnet, BTG, DASH, DOGE, LTC
+func (c *Client) GetTxOut(ctx context.Context, chain Blockchain, txID string, vout uint64,
+	includeMempool bool) (*TxOut, error) {
+
+	// Validate the input
+	if !chain.ValidateTxID(txID) {
+		return nil, ErrInvalidTxID
+	}
+
+	// Fire the request (the node returns null if spent or not found)
+	var txOut *TxOut
+	if err := c.call(
+		ctx, getTxOutBlockchains, chain, nodeMethodGetTxOut,
+		[]interface{}{txID, vout, includeMempool}, &txOut,
+	); err != nil {
+		return nil, err
+	} else if txOut == nil {
+		return nil, ErrTxOutNotFound
+	}
+	return txOut, nil
+}
@@ -0,0 +1,278 @@
+package nownodes
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// validUTXOResponse will return valid utxos for all supported blockchains
+type validUTXOResponse struct{}
+
+func (v *validUTXOResponse) Do(req *http.Request) (*http.Response, error) {
+	resp := new(http.Response)
+	resp.StatusCode = http.StatusBadRequest
+
+	// No req found
+	if req == nil {
+		return resp, errors.New("missing request")
+	}
+
+	// Unconfirmed output (only if not excluded)
+	unconfirmed := `,{"txid":"` + testBTCTxHexID + `","vout":0,"value":"35788","confirmations":0,"lockTime":2101822}`
+	if req.URL.Query().Get("confirmed") == "true" {
+		unconfirmed = ``
+	}
+
+	// Valid response (address or xpub)
+	for _, chain := range getUTXOsBlockchains {
+		if strings.Contains(req.Host, chain.BlockBookURL()) &&
+			(strings.Contains(req.URL.String(), routeGetUTXO+testAddress(chain)) || strings.Contains(req.URL.String(), routeGetUTXO+testXPub)) {
+			resp.StatusCode = http.StatusOK
+			resp.Body = io.NopCloser(bytes.NewBuffer([]byte(`[{"txid":"` + testTxID(chain) + `","vout":1,"value":"96496","height":720943,"confirmations":12}` + unconfirmed + `]`)))
+			return resp, nil
+		}
+	}
+
+	resp.Body = io.NopCloser(bytes.NewBuffer([]byte(`{"error":"no-route-found"}`)))
+	return resp, errors.New("request not found")
+}
+
+func TestClient_GetUTXOs(t *testing.T) {
+	t.Parallel()
+
+	t.Run("valid cases", func(t *testing.T) {
+		c := NewClient(WithAPIKey(testKey), WithHTTPClient(&validUTXOResponse{}))
+		ctx := context.Background()
+
+		for _, chain := range getUTXOsBlockchains {
+			t.Run("chain "+chain.String()+": GetUTXOs("+testAddress(chain)+")", func(t *testing.T) {
+				utxos, err := c.GetUTXOs(ctx, chain, testAddress(chain), false)
+				require.NoError(t, err)
+				require.Len(t, utxos, 2)
+
+				assert.Equal(t, testTxID(chain), utxos[0].TxID)
+				assert.Equal(t, uint64(1), utxos[0].VOut)
+				assert.Equal(t, "96496", utxos[0].Value)
+				assert.Equal(t, uint64(720943), utxos[0].Height)
+				assert.Equal(t, int64(12), utxos[0].Confirmations)
+				assert.Equal(t, int64(0), utxos[1].Confirmations)
+				assert.Equal(t, int64(2101822), utxos[1].LockTime)
+			})
+		}
+	})
+
+	t.Run("confirmed only", func(t *testing.T) {
+		c := NewClient(WithHTTPClient(&validUTXOResponse{}))
+		utxos, err := c.GetUTXOs(context.Background(), BTC, testAddress(BTC), true)
+		require.NoError(t, err)
+		require.Len(t, utxos, 1)
+		assert.Equal(t, testTxID(BTC), utxos[0].TxID)
+	})
+
+	t.Run("xpub", func(t *testing.T) {
+		c := NewClient(WithHTTPClient(&validUTXOResponse{}))
+		utxos, err := c.GetUTXOs(context.Background(), BTC, testXPub, false)
+		require.NoError(t, err)
+		require.Len(t, utxos, 2)
+	})
+
+	t.Run("missing or invalid address", func(t *testing.T) {
+		c := NewClient(WithHTTPClient(&validUTXOResponse{}))
+		for _, chain := range getUTXOsBlockchains {
+			for _, address := range []string{"", "12345", "invalid-address", strings.Repeat("a", 111), testXPrv} {
+				utxos, err := c.GetUTXOs(context.Background(), chain, address, false)
+				require.Error(t, err)
+				require.Nil(t, utxos)
+				assert.ErrorIs(t, err, ErrInvalidAddress)
+			}
+		}
+	})
+
+	t.Run("unsupported chain", func(t *testing.T) {
+		c := NewClient(WithHTTPClient(&validUTXOResponse{}))
+		utxos, err := c.GetUTXOs(context.Background(), ETH, testAddress(ETH), false)
+		require.Error(t, err)
+		require.Nil(t, utxos)
+		assert.ErrorIs(t, err, ErrUnsupportedBlockchain)
+	})
+
+	t.Run("error cases", func(t *testing.T) {
+		for _, chain := range getUTXOsBlockchains {
+			t.Run("chain "+chain.String()+": address invalid", func(t *testing.T) {
+				c := NewClient(WithHTTPClient(&errorInvalidAddress{}))
+				utxos, err := c.GetUTXOs(context.Background(), chain, testAddress(chain), false)
+				require.Error(t, err)
+				require.Nil(t, utxos)
+			})
+
+			t.Run("chain "+chain.String()+": http req error", func(t *testing.T) {
+				c := NewClient(WithHTTPClient(&errorDoReqErr{}))
+				utxos, err := c.GetUTXOs(context.Background(), chain, testAddress(chain), false)
+				require.Error(t, err)
+				require.Nil(t, utxos)
+			})
+
+			t.Run("chain "+chain.String()+": invalid json response", func(t *testing.T) {
+				c := NewClient(WithHTTPClient(&errorBadJSONResponse{}))
+				utxos, err := c.GetUTXOs(context.Background(), chain, testAddress(chain), false)
+				require.Error(t, err)
+				require.Nil(t, utxos)
+			})
+		}
+	})
+}
+
+// validTxOutResponse will return an unspent output for vout 0 and vout 1 (only if the mempool is excluded)
+type validTxOutResponse struct{}
+
+func (v *validTxOutResponse) Do(req *http.Request) (*http.Response, error) {
+	resp := new(http.Response)
+	resp.StatusCode = http.StatusBadRequest
+
+	// No req found
+	if req == nil {
+		return resp, errors.New("missing request")
+	}
+
+	var data nodePayload
+	if err := json.NewDecoder(req.Body).Decode(&data); err != nil {
+		return resp, err
+	}
+
+	for _, chain := range getTxOutBlockchains {
+		if strings.Contains(req.Host, chain.NodeAPIURL()) && data.Method == nodeMethodGetTxOut {
+
+			// Vout 0 is unspent, vout 1 is spent in the mempool, anything else is spent or not found
+			result := `null`
+			vout, _ := data.Params[1].(float64)
+			includeMempool, _ := data.Params[2].(bool)
+			if vout == 0 || (vout == 1 && !includeMempool) {
+				result = `{"bestblock":"` + testBlockHash + `","confirmations":12,"value":0.00035788,"scriptPubKey":{"asm":"0 ef0c54cd24cd6036662dab76123d133b99e58423","hex":"0014ef0c54cd24cd6036662dab76123d133b99e58423","address":"bc1qaux9fnfye5srve3d4dmpy0gn8wv7tppr7ykz65","type":"witness_v0_keyhash"},"coinbase":false}`
+			}
+			resp.StatusCode = http.StatusOK
+			resp.Body = io.NopCloser(bytes.NewBuffer([]byte(`{"result": ` + result + `,"error": null,"id": "` + data.ID + `"}`)))
+			return resp, nil
+		}
+	}
+
+	resp.Body = io.NopCloser(bytes.NewBuffer([]byte(`{"error":"no-route-found"}`)))
+	return resp, errors.New("request not found")
+}
+
+func TestClient_GetTxOut(t *testing.T) {
+	t.Parallel()
+
+	t.Run("valid cases", func(t *testing.T) {
+		c := NewClient(WithAPIKey(testKey), WithHTTPClient(&validTxOutResponse{}))
+		for _, chain := range getTxOutBlockchains {
+			t.Run("chain "+chain.String()+": GetTxOut()", func(t *testing.T) {
+				txOut, err := c.GetTxOut(context.Background(), chain, testTxID(chain), 0, true)
+				require.NoError(t, err)
+				require.NotNil(t, txOut)
+				assert.Equal(t, testBlockHash, txOut.BestBlock)
+				assert.Equal(t, int64(12), txOut.Confirmations)
+				assert.False(t, txOut.Coinbase)
+				assert.InDelta(t, 0.00035788, txOut.Value, 0.000000001)
+				require.NotNil(t, txOut.ScriptPubKey)
+				assert.Equal(t, "witness_v0_keyhash", txOut.ScriptPubKey.Type)
+				assert.Equal(t, "bc1qaux9fnfye5srve3d4dmpy0gn8wv7tppr7ykz65", txOut.ScriptPubKey.Address)
+			})
+		}
+	})
+
+	t.Run("spent in mempool", func(t *testing.T) {
+		c := NewClient(WithHTTPClient(&validTxOutResponse{}))
+		txOut, err := c.GetTxOut(context.Background(), BTC, testTxID(BTC), 1, false)
+		require.NoError(t, err)
+		require.NotNil(t, txOut)
+
+		txOut, err = c.GetTxOut(context.Background(), BTC, testTxID(BTC), 1, true)
+		require.Error(t, err)
+		require.Nil(t, txOut)
+		assert.ErrorIs(t, err, ErrTxOutNotFound)
+	})
+
+	t.Run("spent or not found", func(t *testing.T) {
+		c := NewClient(WithHTTPClient(&validTxOutResponse{}))
+		txOut, err := c.GetTxOut(context.Background(), BTC, testTxID(BTC), 5, false)
+		require.Error(t, err)
+		require.Nil(t, txOut)
+		assert.ErrorIs(t, err, ErrTxOutNotFound)
+	})
+
+	t.Run("invalid tx id", func(t *testing.T) {
+		c := NewClient(WithHTTPClient(&validTxOutResponse{}))
+		txOut, err := c.GetTxOut(context.Background(), BTC, "12345", 0, true)
+		require.Error(t, err)
+		require.Nil(t, txOut)
+		assert.ErrorIs(t, err, ErrInvalidTxID)
+	})
+
+	t.Run("unsupported chain", func(t *testing.T) {
+		c := NewClient(WithHTTPClient(&validTxOutResponse{}))
+		txOut, err := c.GetTxOut(context.Background(), ETH, testTxID(ETH), 0, true)
+		require.Error(t, err)
+		require.Nil(t, txOut)
+		assert.ErrorIs(t, err, ErrUnsupportedBlockchain)
+	})
+
+	t.Run("error cases", func(t *testing.T) {
+		c := NewClient(WithHTTPClient(&nodeMethodResponse{}))
+		txOut, err := c.GetTxOut(context.Background(), BTC, testTxID(BTC), 0, true)
+		require.Error(t, err)
+		require.Nil(t, txOut)
+		assert.NotErrorIs(t, err, ErrTxOutNotFound)
+
+		c = NewClient(WithHTTPClient(&errorDoReqErr{}))
+		txOut, err = c.GetTxOut(context.Background(), BTC, testTxID(BTC), 0, true)
+		require.Error(t, err)
+		require.Nil(t, txOut)
+
+		c = NewClient(WithHTTPClient(&errorBadJSONResponse{}))
+		txOut, err = c.GetTxOut(context.Background(), BTC, testTxID(BTC), 0, true)
+		require.Error(t, err)
+		require.Nil(t, txOut)
+	})
+}
+
+func ExampleClient_GetUTXOs() {
+	c := NewClient(WithHTTPClient(&validUTXOResponse{}))
+	utxos, _ := c.GetUTXOs(context.Background(), BSV, testAddress(BSV), true)
+	fmt.Printf("utxos found: %d", len(utxos))
+	// Output:utxos found: 1
+}
+
+func BenchmarkClient_GetUTXOs(b *testing.B) {
+	c := NewClient(WithHTTPClient(&validUTXOResponse{}))
+	ctx := context.Background()
+	address := testAddress(BSV)
+	for i := 0; i < b.N; i++ {
+		_, _ = c.GetUTXOs(ctx, BSV, address, false)
+	}
+}
+
+func ExampleClient_GetTxOut() {
+	c := NewClient(WithHTTPClient(&validTxOutResponse{}))
+	_, err := c.GetTxOut(context.Background(), BTC, testTxID(BTC), 1, true)
+	fmt.Println(err)
+	// Output:transaction output is spent or not found
+}
+
+func BenchmarkClient_GetTxOut(b *testing.B) {
+	c := NewClient(WithHTTPClient(&validTxOutResponse{}))
+	ctx := context.Background()
+	txID := testTxID(BTC)
+	for i := 0; i < b.N; i++ {
+		_, _ = c.GetTxOut(ctx, BTC, txID, 0, true)
+	}
+}
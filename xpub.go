@@ -0,0 +1,119 @@
+package nownodes
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+)
+
+// DetailLevel is the level of detail returned by BlockBook address & xpub requests
+type DetailLevel string
+
+// Supported detail levels
+const (
+	DetailsBasic         DetailLevel = "basic"         // Balances only
+	DetailsTokenBalances DetailLevel = "tokenBalances" // Balances & tokens with balances
+	DetailsTokens        DetailLevel = "tokens"        // Balances & tokens
+	DetailsTxIDs         DetailLevel = "txids"         // Balances, tokens & paged tx ids (default)
+	DetailsTxs           DetailLevel = "txs"           // Balances, tokens & paged full transactions
+	DetailsTxsLight      DetailLevel = "txslight"      // Balances, tokens & paged transactions without inputs/outputs
+)
+
+// TokenFilter is the filter for the derived addresses (tokens) returned by xpub requests
+type TokenFilter string
+
+// Supported token filters
+const (
+	TokensDerived TokenFilter = "derived" // All derived addresses
+	TokensNonZero TokenFilter = "nonzero" // Only addresses with a non-zero balance (default)
+	TokensUsed    TokenFilter = "used"    // Only addresses with at least one transaction
+)
+
+// XPubOptions are the optional parameters for the GetXPub request
+type XPubOptions struct {
+	Details  DetailLevel `json:"details"`   // Level of detail (default: txids)
+	Gap      uint64      `json:"gap"`       // Gap limit for derived addresses (default: 20)
+	Page     uint64      `json:"page"`      // Page of transactions (default: 1)
+	PageSize uint64      `json:"page_size"` // Transactions per page (default: 1000)
+	Tokens   TokenFilter `json:"tokens"`    // Filter for the derived addresses (default: nonzero)
+}
+
+// XPubInfo is the extended public key information returned to the GetXPub request
+type XPubInfo struct {
+	Address            string             `json:"address"`
+	Balance            string             `json:"balance"`
+	ItemsOnPage        uint64             `json:"itemsOnPage"`
+	Page               uint64             `json:"page"`
+	Tokens             []*Token           `json:"tokens,omitempty"`
+	TotalPages         uint64             `json:"totalPages"`
+	TotalReceived      string             `json:"totalReceived"`
+	TotalSent          string             `json:"totalSent"`
+	Transactions       []*TransactionInfo `json:"transactions,omitempty"`
+	TxIDs              []string           `json:"txids,omitempty"`
+	Txs                uint64             `json:"txs"`
+	UnconfirmedBalance string             `json:"unconfirmedBalance"`
+	UnconfirmedTxs     uint64             `json:"unconfirmedTxs"`
+	UsedTokens         uint64             `json:"usedTokens"`
+}
+
+// Token is a derived address of an xpub (or a token of an address)
+type Token struct {
+	Balance       string `json:"balance,omitempty"`
+	Contract      string `json:"contract,omitempty"` // ETH
+	Decimals      uint64 `json:"decimals"`
+	Name          string `json:"name"`
+	Path          string `json:"path,omitempty"`
+	Symbol        string `json:"symbol,omitempty"` // ETH
+	TotalReceived string `json:"totalReceived,omitempty"`
+	TotalSent     string `json:"totalSent,omitempty"`
+	Transfers     uint64 `json:"transfers"`
+	Type          string `json:"type"` // IE: XPUBAddress or ERC20
+}
+
+// GetXPub will get extended public key (xpub/ypub/zpub) information by a given xpub
+//
+// param: options is optional (nil will use the BlockBook defaults)
+// This method supports the following chains: BCH, BSV, BTC, BTCTestnet, BTG, DASH, DOGE, LTC
+func (c *Client) GetXPub(ctx context.Context, chain Blockchain, xPub string,
+	options *XPubOptions) (*XPubInfo, error) {
+
+	// Validate the input
+	if !isBlockchainSupported(getXPubBlockchains, chain) {
+		return nil, ErrUnsupportedBlockchain
+	} else if !chain.ValidateXPub(xPub) {
+		return nil, ErrInvalidXPub
+	}
+
+	// Fire the HTTP request
+	info := new(XPubInfo)
+	if err := blockBookRequest(
+		ctx, c, getXPubBlockchains, chain, routeGetXPub+xPub+options.queryString(), &info,
+	); err != nil {
+		return nil, err
+	}
+	return info, nil
+}
+
+// queryString will return the url query string for the options (if any)
+func (o *XPubOptions) queryString() string {
+	if o == nil {
+		return ""
+	}
+	values := url.Values{}
+	if len(o.Details) > 0 {
+		values.Set("details", string(o.Details))
+	}
+	if o.Gap > 0 {
+		values.Set("gap", strconv.FormatUint(o.Gap, 10))
+	}
+	if o.Page > 0 {
+		values.Set("page", strconv.FormatUint(o.Page, 10))
+	}
+	if o.PageSize > 0 {
+		values.Set("pageSize", strconv.FormatUint(o.PageSize, 10))
+	}
+	if len(o.Tokens) > 0 {
+		values.Set("tokens", string(o.Tokens))
+	}
+	return encodeQuery(values)
+}
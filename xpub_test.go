@@ -0,0 +1,169 @@
+package nownodes
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testXPub = "xpub6CUGRUonZSQ4TWtTMmzXdrXDtypWKiKrhko4egpiMZbpiaQL2jkwSB1icqYh2cfDfVxdx4df189oLKnC5fSwqPfgyP3hooxujYzAu3fDVmz"
+	testXPrv = "xprv9s21ZrQH143K3QTDL4LXw2F7HEK3wJUD2nW2nRk4stbPy6cq3jPPqjiChijnGjawKx7dL85s6KCnYwTAjzadeBai6CA6hRa1zNNa5D2CJTm" // Never sent
+)
+
+// validXPubResponse will return a valid xpub for all supported blockchains
+type validXPubResponse struct{}
+
+func (v *validXPubResponse) Do(req *http.Request) (*http.Response, error) {
+	resp := new(http.Response)
+	resp.StatusCode = http.StatusBadRequest
+
+	// No req found
+	if req == nil {
+		return resp, errors.New("missing request")
+	}
+
+	// Valid response (tokens are only returned when requested)
+	for _, chain := range getXPubBlockchains {
+		if strings.Contains(req.Host, chain.BlockBookURL()) && strings.Contains(req.URL.String(), routeGetXPub+testXPub) {
+			tokens := ``
+			if req.URL.Query().Get("tokens") == string(TokensUsed) {
+				tokens = `,"tokens":[{"type":"XPUBAddress","name":"1EfgV2Hr5CDjXPavHDpDMjmU33BA2veHy6","path":"m/44'/0'/0'/0/0","transfers":2,"decimals":8,"balance":"0","totalReceived":"1000","totalSent":"1000"},{"type":"XPUBAddress","name":"12tGGuawKdkw5NeDEzS3UANhCRa1XggBbK","path":"m/44'/0'/0'/0/1","transfers":1,"decimals":8,"balance":"2000","totalReceived":"2000","totalSent":"0"}]`
+			}
+			resp.StatusCode = http.StatusOK
+			resp.Body = io.NopCloser(bytes.NewBuffer([]byte(`{"page":1,"totalPages":1,"itemsOnPage":1000,"address":"` + testXPub + `","balance":"2000","totalReceived":"3000","totalSent":"1000","unconfirmedBalance":"0","unconfirmedTxs":0,"txs":3,"txids":["` + testBitcoinTxID + `"],"usedTokens":2` + tokens + `}`)))
+			return resp, nil
+		}
+	}
+
+	resp.Body = io.NopCloser(bytes.NewBuffer([]byte(`{"error":"no-route-found"}`)))
+	return resp, errors.New("request not found")
+}
+
+func TestClient_GetXPub(t *testing.T) {
+	t.Parallel()
+
+	t.Run("valid cases", func(t *testing.T) {
+		c := NewClient(WithAPIKey(testKey), WithHTTPClient(&validXPubResponse{}))
+		ctx := context.Background()
+
+		for _, chain := range getXPubBlockchains {
+			t.Run("chain "+chain.String()+": GetXPub()", func(t *testing.T) {
+				info, err := c.GetXPub(ctx, chain, testXPub, nil)
+				require.NoError(t, err)
+				require.NotNil(t, info)
+
+				assert.Equal(t, testXPub, info.Address)
+				assert.Equal(t, "2000", info.Balance)
+				assert.Equal(t, "3000", info.TotalReceived)
+				assert.Equal(t, "1000", info.TotalSent)
+				assert.Equal(t, uint64(3), info.Txs)
+				assert.Equal(t, uint64(2), info.UsedTokens)
+				assert.Equal(t, []string{testBitcoinTxID}, info.TxIDs)
+				assert.Empty(t, info.Tokens)
+			})
+		}
+	})
+
+	t.Run("valid case with options", func(t *testing.T) {
+		c := NewClient(WithHTTPClient(&validXPubResponse{}))
+		info, err := c.GetXPub(context.Background(), BTC, testXPub, &XPubOptions{
+			Details: DetailsTokenBalances,
+			Gap:     30,
+			Tokens:  TokensUsed,
+		})
+		require.NoError(t, err)
+		require.NotNil(t, info)
+		require.Len(t, info.Tokens, 2)
+		assert.Equal(t, "1EfgV2Hr5CDjXPavHDpDMjmU33BA2veHy6", info.Tokens[0].Name)
+		assert.Equal(t, "m/44'/0'/0'/0/0", info.Tokens[0].Path)
+		assert.Equal(t, uint64(2), info.Tokens[0].Transfers)
+		assert.Equal(t, "2000", info.Tokens[1].Balance)
+	})
+
+	t.Run("missing or invalid xpub", func(t *testing.T) {
+		c := NewClient(WithHTTPClient(&validXPubResponse{}))
+		for _, xPub := range []string{
+			"", "12345", testAddress(BTC), strings.Repeat("a", 111), testXPub[:110] + "a", testXPrv,
+		} {
+			info, err := c.GetXPub(context.Background(), BTC, xPub, nil)
+			require.Error(t, err)
+			require.Nil(t, info)
+			assert.ErrorIs(t, err, ErrInvalidXPub)
+		}
+	})
+
+	t.Run("unsupported chain", func(t *testing.T) {
+		c := NewClient(WithHTTPClient(&validXPubResponse{}))
+		info, err := c.GetXPub(context.Background(), ETH, testXPub, nil)
+		require.Error(t, err)
+		require.Nil(t, info)
+		assert.ErrorIs(t, err, ErrUnsupportedBlockchain)
+	})
+
+	t.Run("error cases", func(t *testing.T) {
+		for _, chain := range getXPubBlockchains {
+			t.Run("chain "+chain.String()+": http req error", func(t *testing.T) {
+				c := NewClient(WithHTTPClient(&errorDoReqErr{}))
+				info, err := c.GetXPub(context.Background(), chain, testXPub, nil)
+				require.Error(t, err)
+				require.Nil(t, info)
+			})
+
+			t.Run("chain "+chain.String()+": invalid json response", func(t *testing.T) {
+				c := NewClient(WithHTTPClient(&errorBadJSONResponse{}))
+				info, err := c.GetXPub(context.Background(), chain, testXPub, nil)
+				require.Error(t, err)
+				require.Nil(t, info)
+			})
+
+			t.Run("chain "+chain.String()+": missing api key", func(t *testing.T) {
+				c := NewClient(WithHTTPClient(&errorMissingAPIKey{}))
+				info, err := c.GetXPub(context.Background(), chain, testXPub, nil)
+				require.Error(t, err)
+				require.Nil(t, info)
+			})
+		}
+	})
+}
+
+func TestXPubOptions_queryString(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		options  *XPubOptions
+		expected string
+	}{
+		{nil, ""},
+		{&XPubOptions{}, ""},
+		{&XPubOptions{Details: DetailsBasic}, "?details=basic"},
+		{&XPubOptions{Gap: 25, Tokens: TokensDerived}, "?gap=25&tokens=derived"},
+		{&XPubOptions{Page: 2, PageSize: 50}, "?page=2&pageSize=50"},
+	}
+	for _, testCase := range tests {
+		assert.Equal(t, testCase.expected, testCase.options.queryString())
+	}
+}
+
+func ExampleClient_GetXPub() {
+	c := NewClient(WithHTTPClient(&validXPubResponse{}))
+	info, _ := c.GetXPub(context.Background(), BTC, testXPub, nil)
+	fmt.Println("xpub balance: " + info.Balance)
+	// Output:xpub balance: 2000
+}
+
+func BenchmarkClient_GetXPub(b *testing.B) {
+	c := NewClient(WithHTTPClient(&validXPubResponse{}))
+	ctx := context.Background()
+	for i := 0; i < b.N; i++ {
+		_, _ = c.GetXPub(ctx, BTC, testXPub, nil)
+	}
+}